@@ -0,0 +1,99 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+const (
+	// DefaultUserHeader is the header carrying the user id authenticated by the proxy in front of the server
+	DefaultUserHeader = "X-User-ID"
+
+	bearerPrefix = "Bearer "
+)
+
+type userIDKey struct{}
+
+// WithUserID returns a copy of ctx carrying the authenticated user id
+func WithUserID(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, id)
+}
+
+// UserID returns the authenticated user id of the context
+func UserID(ctx context.Context) (int64, bool) {
+	id, ok := ctx.Value(userIDKey{}).(int64)
+	return id, ok
+}
+
+// Middleware authenticates a request by the user id header, which is only honored
+// if the request is sent from one of the trusted (authenticating) proxies.
+// Requests without a valid header are served as unauthenticated ones
+func Middleware(proxies utils.TrustedProxies, header string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			v := req.Header.Get(header)
+			if v == "" || !proxies.IsTrusted(req) {
+				next.ServeHTTP(w, req)
+				return
+			}
+			id, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				next.ServeHTTP(w, req)
+				return
+			}
+			next.ServeHTTP(w, req.WithContext(WithUserID(req.Context(), id)))
+		})
+	}
+}
+
+// RequireToken rejects requests whose bearer token in the Authorization header is not token
+func RequireToken(token string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			given := strings.TrimPrefix(req.Header.Get("Authorization"), bearerPrefix)
+			if token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				_ = utils.RespondError(w, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+// AuthorizeUser responds 401 Unauthorized if the request is not authenticated, and 403 Forbidden if the authenticated
+// user is not userID, i.e., the user of the path. It returns false if the response is done
+func AuthorizeUser(w http.ResponseWriter, req *http.Request, userID int64) bool {
+	id, ok := UserID(req.Context())
+	if !ok {
+		_ = utils.RespondError(w, http.StatusUnauthorized, "authentication required")
+		return false
+	}
+	if id != userID {
+		_ = utils.RespondError(w, http.StatusForbidden, "requests of other users are not allowed")
+		return false
+	}
+	return true
+}
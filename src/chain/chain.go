@@ -0,0 +1,155 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package chain reads the blocks of the chains by their JSON-RPC endpoints
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// requestTimeout is the timeout of each JSON-RPC request
+const requestTimeout = 10 * time.Second
+
+// ErrNoEndpoint is returned for the chains without JSON-RPC endpoints
+var ErrNoEndpoint = errors.New("no JSON-RPC endpoint of the chain is configured")
+
+// Block is a block of a chain
+type Block struct {
+	Number uint64
+	// Hash is the hash of the block in 0x-prefixed hex
+	Hash string
+	Time time.Time
+}
+
+// Client gets the blocks of the chains by their JSON-RPC endpoints
+type Client struct {
+	endpoints map[int64]string
+	http      *http.Client
+}
+
+// ParseEndpoints parses comma-separated JSON-RPC endpoints by their chain ids
+// (e.g., "1=https://eth.example.com,137=https://polygon.example.com")
+func ParseEndpoints(spec string) (map[int64]string, error) {
+	endpoints := map[int64]string{}
+	for _, item := range utils.SplitList(spec) {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid chain endpoint %q", item)
+		}
+		id, err := strconv.ParseInt(strings.TrimSpace(kv[0]), 10, 64)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid chain id %q", kv[0])
+		}
+		u, err := url.Parse(strings.TrimSpace(kv[1]))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid chain endpoint %q", kv[1])
+		}
+		endpoints[id] = u.String()
+	}
+	return endpoints, nil
+}
+
+// NewClient returns a client of the endpoints by their chain ids
+func NewClient(endpoints map[int64]string) *Client {
+	return &Client{endpoints: endpoints, http: &http.Client{Timeout: requestTimeout}}
+}
+
+// BlockByNumber returns the block of the chain by its number. It returns nil if the block has not been produced yet
+func (c *Client) BlockByNumber(ctx context.Context, chainID int64, number uint64) (*Block, error) {
+	endpoint, ok := c.endpoints[chainID]
+	if !ok {
+		return nil, ErrNoEndpoint
+	}
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_getBlockByNumber",
+		"params":  []interface{}{"0x" + strconv.FormatUint(number, 16), false},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("chain %d responded %s", chainID, resp.Status)
+	}
+
+	rpcResp := &struct {
+		Result *struct {
+			Number    string `json:"number"`
+			Hash      string `json:"hash"`
+			Timestamp string `json:"timestamp"`
+		} `json:"result"`
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(rpcResp); err != nil {
+		return nil, fmt.Errorf("chain %d responded malformed json: %v", chainID, err)
+	}
+	if rpcResp.Error != nil {
+		return nil, fmt.Errorf("chain %d responded error %d: %s", chainID, rpcResp.Error.Code, rpcResp.Error.Message)
+	}
+	if rpcResp.Result == nil {
+		return nil, nil
+	}
+
+	n, err := parseQuantity(rpcResp.Result.Number)
+	if err != nil || n != number {
+		return nil, fmt.Errorf("chain %d responded block %q for %d", chainID, rpcResp.Result.Number, number)
+	}
+	timestamp, err := parseQuantity(rpcResp.Result.Timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("chain %d responded invalid timestamp %q", chainID, rpcResp.Result.Timestamp)
+	}
+	hash, err := hex.DecodeString(strings.TrimPrefix(rpcResp.Result.Hash, "0x"))
+	if err != nil || len(hash) != 32 {
+		return nil, fmt.Errorf("chain %d responded invalid hash %q", chainID, rpcResp.Result.Hash)
+	}
+	return &Block{Number: n, Hash: "0x" + hex.EncodeToString(hash), Time: time.Unix(int64(timestamp), 0).UTC()}, nil
+}
+
+// parseQuantity parses a 0x-prefixed hex quantity of JSON-RPC
+func parseQuantity(s string) (uint64, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return 0, fmt.Errorf("must be 0x-prefixed hex")
+	}
+	return strconv.ParseUint(s[2:], 16, 64)
+}
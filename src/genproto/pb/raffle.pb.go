@@ -23,11 +23,15 @@ package pb
 
 import (
 	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -88,6 +92,114 @@ func (LoginType) EnumDescriptor() ([]byte, []int) {
 	return file_pb_raffle_proto_rawDescGZIP(), []int{0}
 }
 
+type ProjectStatus int32
+
+const (
+	ProjectStatus_DRAFT     ProjectStatus = 0
+	ProjectStatus_UPCOMING  ProjectStatus = 1
+	ProjectStatus_OPEN      ProjectStatus = 2
+	ProjectStatus_CLOSED    ProjectStatus = 3
+	ProjectStatus_DRAWN     ProjectStatus = 4
+	ProjectStatus_CANCELLED ProjectStatus = 5
+)
+
+// Enum value maps for ProjectStatus.
+var (
+	ProjectStatus_name = map[int32]string{
+		0: "DRAFT",
+		1: "UPCOMING",
+		2: "OPEN",
+		3: "CLOSED",
+		4: "DRAWN",
+		5: "CANCELLED",
+	}
+	ProjectStatus_value = map[string]int32{
+		"DRAFT":     0,
+		"UPCOMING":  1,
+		"OPEN":      2,
+		"CLOSED":    3,
+		"DRAWN":     4,
+		"CANCELLED": 5,
+	}
+)
+
+func (x ProjectStatus) Enum() *ProjectStatus {
+	p := new(ProjectStatus)
+	*p = x
+	return p
+}
+
+func (x ProjectStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_raffle_proto_enumTypes[1].Descriptor()
+}
+
+func (ProjectStatus) Type() protoreflect.EnumType {
+	return &file_pb_raffle_proto_enumTypes[1]
+}
+
+func (x ProjectStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectStatus.Descriptor instead.
+func (ProjectStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{1}
+}
+
+// ProjectRole is the role of a user in a project. The roles are in the ascending order of the permissions
+type ProjectRole int32
+
+const (
+	ProjectRole_VIEWER ProjectRole = 0
+	ProjectRole_ADMIN  ProjectRole = 1
+	ProjectRole_OWNER  ProjectRole = 2
+)
+
+// Enum value maps for ProjectRole.
+var (
+	ProjectRole_name = map[int32]string{
+		0: "VIEWER",
+		1: "ADMIN",
+		2: "OWNER",
+	}
+	ProjectRole_value = map[string]int32{
+		"VIEWER": 0,
+		"ADMIN":  1,
+		"OWNER":  2,
+	}
+)
+
+func (x ProjectRole) Enum() *ProjectRole {
+	p := new(ProjectRole)
+	*p = x
+	return p
+}
+
+func (x ProjectRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_raffle_proto_enumTypes[2].Descriptor()
+}
+
+func (ProjectRole) Type() protoreflect.EnumType {
+	return &file_pb_raffle_proto_enumTypes[2]
+}
+
+func (x ProjectRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectRole.Descriptor instead.
+func (ProjectRole) EnumDescriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{2}
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -195,11 +307,12 @@ type GetUserResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID     int64     `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	LoginType  LoginType `protobuf:"varint,2,opt,name=loginType,proto3,enum=pb.LoginType" json:"loginType,omitempty"`
-	TelegramID string    `protobuf:"bytes,3,opt,name=telegramID,proto3" json:"telegramID,omitempty"`
-	DiscordID  string    `protobuf:"bytes,4,opt,name=discordID,proto3" json:"discordID,omitempty"`
-	TwitterID  string    `protobuf:"bytes,5,opt,name=twitterID,proto3" json:"twitterID,omitempty"`
+	UserID     int64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	LoginType  LoginType              `protobuf:"varint,2,opt,name=loginType,proto3,enum=pb.LoginType" json:"loginType,omitempty"`
+	TelegramID string                 `protobuf:"bytes,3,opt,name=telegramID,proto3" json:"telegramID,omitempty"`
+	DiscordID  string                 `protobuf:"bytes,4,opt,name=discordID,proto3" json:"discordID,omitempty"`
+	TwitterID  string                 `protobuf:"bytes,5,opt,name=twitterID,proto3" json:"twitterID,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
 func (x *GetUserResponse) Reset() {
@@ -269,6 +382,13 @@ func (x *GetUserResponse) GetTwitterID() string {
 	return ""
 }
 
+func (x *GetUserResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type UpdateUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -348,6 +468,9 @@ type UserWallet struct {
 	UserID  int64  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	ChainID int64  `protobuf:"varint,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// primary is true for the default wallet of the user on the chain. A user has at most one primary wallet per chain
+	Primary bool   `protobuf:"varint,4,opt,name=primary,proto3" json:"primary,omitempty"`
+	Label   string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (x *UserWallet) Reset() {
@@ -403,6 +526,142 @@ func (x *UserWallet) GetAddress() string {
 	return ""
 }
 
+func (x *UserWallet) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *UserWallet) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// UpdateUserWalletRequest updates the fields of the wallet in updateMask (primary and label). Setting primary unsets
+// the other primary wallet of the user on the chain
+type UpdateUserWalletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wallet     *UserWallet            `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *UpdateUserWalletRequest) Reset() {
+	*x = UpdateUserWalletRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserWalletRequest) ProtoMessage() {}
+
+func (x *UpdateUserWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserWalletRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserWalletRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateUserWalletRequest) GetWallet() *UserWallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+func (x *UpdateUserWalletRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserWalletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  int64  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ChainID int64  `protobuf:"varint,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *DeleteUserWalletRequest) Reset() {
+	*x = DeleteUserWalletRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserWalletRequest) ProtoMessage() {}
+
+func (x *DeleteUserWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserWalletRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserWalletRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteUserWalletRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteUserWalletRequest) GetChainID() int64 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *DeleteUserWalletRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// CreateUserWalletRequest links the wallet to the user. Creating a primary wallet unsets the other primary wallet of
+// the user on the chain
 type CreateUserWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -414,7 +673,7 @@ type CreateUserWalletRequest struct {
 func (x *CreateUserWalletRequest) Reset() {
 	*x = CreateUserWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[5]
+		mi := &file_pb_raffle_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -427,7 +686,7 @@ func (x *CreateUserWalletRequest) String() string {
 func (*CreateUserWalletRequest) ProtoMessage() {}
 
 func (x *CreateUserWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[5]
+	mi := &file_pb_raffle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +699,7 @@ func (x *CreateUserWalletRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserWalletRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserWalletRequest) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{5}
+	return file_pb_raffle_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateUserWalletRequest) GetWallet() *UserWallet {
@@ -461,7 +720,7 @@ type GetUserWalletRequest struct {
 func (x *GetUserWalletRequest) Reset() {
 	*x = GetUserWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[6]
+		mi := &file_pb_raffle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -474,7 +733,7 @@ func (x *GetUserWalletRequest) String() string {
 func (*GetUserWalletRequest) ProtoMessage() {}
 
 func (x *GetUserWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[6]
+	mi := &file_pb_raffle_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +746,7 @@ func (x *GetUserWalletRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserWalletRequest.ProtoReflect.Descriptor instead.
 func (*GetUserWalletRequest) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{6}
+	return file_pb_raffle_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetUserWalletRequest) GetUserID() int64 {
@@ -508,7 +767,7 @@ type GetUserWalletResponse struct {
 func (x *GetUserWalletResponse) Reset() {
 	*x = GetUserWalletResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[7]
+		mi := &file_pb_raffle_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -521,7 +780,7 @@ func (x *GetUserWalletResponse) String() string {
 func (*GetUserWalletResponse) ProtoMessage() {}
 
 func (x *GetUserWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[7]
+	mi := &file_pb_raffle_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +793,7 @@ func (x *GetUserWalletResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserWalletResponse.ProtoReflect.Descriptor instead.
 func (*GetUserWalletResponse) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{7}
+	return file_pb_raffle_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUserWalletResponse) GetWallets() []*UserWallet {
@@ -558,7 +817,7 @@ type CreateUserProjectRequest struct {
 func (x *CreateUserProjectRequest) Reset() {
 	*x = CreateUserProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[8]
+		mi := &file_pb_raffle_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -571,7 +830,7 @@ func (x *CreateUserProjectRequest) String() string {
 func (*CreateUserProjectRequest) ProtoMessage() {}
 
 func (x *CreateUserProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[8]
+	mi := &file_pb_raffle_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +843,7 @@ func (x *CreateUserProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserProjectRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{8}
+	return file_pb_raffle_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateUserProjectRequest) GetUserID() int64 {
@@ -615,31 +874,35 @@ func (x *CreateUserProjectRequest) GetAddress() string {
 	return ""
 }
 
-type GetUserProjectRequest struct {
+// UpdateUserProjectRequest changes the chain and the address of the entry of the user in the project
+type UpdateUserProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	UserID    int64  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ProjectID int64  `protobuf:"varint,2,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	ChainID   int64  `protobuf:"varint,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Address   string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *GetUserProjectRequest) Reset() {
-	*x = GetUserProjectRequest{}
+func (x *UpdateUserProjectRequest) Reset() {
+	*x = UpdateUserProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[9]
+		mi := &file_pb_raffle_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserProjectRequest) String() string {
+func (x *UpdateUserProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProjectRequest) ProtoMessage() {}
+func (*UpdateUserProjectRequest) ProtoMessage() {}
 
-func (x *GetUserProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[9]
+func (x *UpdateUserProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,43 +913,66 @@ func (x *GetUserProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProjectRequest.ProtoReflect.Descriptor instead.
-func (*GetUserProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdateUserProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetUserProjectRequest) GetUserID() int64 {
+func (x *UpdateUserProjectRequest) GetUserID() int64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-type GetUserProjectResponse struct {
+func (x *UpdateUserProjectRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *UpdateUserProjectRequest) GetChainID() int64 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *UpdateUserProjectRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// DeleteUserProjectRequest withdraws the entry of the user from the project
+type DeleteUserProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Projects []int64 `protobuf:"varint,1,rep,packed,name=projects,proto3" json:"projects,omitempty"`
+	UserID    int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ProjectID int64 `protobuf:"varint,2,opt,name=projectID,proto3" json:"projectID,omitempty"`
 }
 
-func (x *GetUserProjectResponse) Reset() {
-	*x = GetUserProjectResponse{}
+func (x *DeleteUserProjectRequest) Reset() {
+	*x = DeleteUserProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[10]
+		mi := &file_pb_raffle_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserProjectResponse) String() string {
+func (x *DeleteUserProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProjectResponse) ProtoMessage() {}
+func (*DeleteUserProjectRequest) ProtoMessage() {}
 
-func (x *GetUserProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[10]
+func (x *DeleteUserProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,44 +983,50 @@ func (x *GetUserProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProjectResponse.ProtoReflect.Descriptor instead.
-func (*GetUserProjectResponse) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteUserProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetUserProjectResponse) GetProjects() []int64 {
+func (x *DeleteUserProjectRequest) GetUserID() int64 {
 	if x != nil {
-		return x.Projects
+		return x.UserID
 	}
-	return nil
+	return 0
 }
 
-type LoginUserRequest struct {
+func (x *DeleteUserProjectRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+type GetUserProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID    string    `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	LoginType LoginType `protobuf:"varint,2,opt,name=loginType,proto3,enum=pb.LoginType" json:"loginType,omitempty"`
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *LoginUserRequest) Reset() {
-	*x = LoginUserRequest{}
+func (x *GetUserProjectRequest) Reset() {
+	*x = GetUserProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[11]
+		mi := &file_pb_raffle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginUserRequest) String() string {
+func (x *GetUserProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginUserRequest) ProtoMessage() {}
+func (*GetUserProjectRequest) ProtoMessage() {}
 
-func (x *LoginUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[11]
+func (x *GetUserProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,50 +1037,45 @@ func (x *LoginUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginUserRequest.ProtoReflect.Descriptor instead.
-func (*LoginUserRequest) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetUserProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LoginUserRequest) GetUserID() string {
+func (x *GetUserProjectRequest) GetUserID() int64 {
 	if x != nil {
 		return x.UserID
 	}
-	return ""
-}
-
-func (x *LoginUserRequest) GetLoginType() LoginType {
-	if x != nil {
-		return x.LoginType
-	}
-	return LoginType_DISCORD
+	return 0
 }
 
-type LoginUserResponse struct {
+type UserProject struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ProjectID int64  `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	ChainID   int64  `protobuf:"varint,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *LoginUserResponse) Reset() {
-	*x = LoginUserResponse{}
+func (x *UserProject) Reset() {
+	*x = UserProject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[12]
+		mi := &file_pb_raffle_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginUserResponse) String() string {
+func (x *UserProject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginUserResponse) ProtoMessage() {}
+func (*UserProject) ProtoMessage() {}
 
-func (x *LoginUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[12]
+func (x *UserProject) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,43 +1086,59 @@ func (x *LoginUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginUserResponse.ProtoReflect.Descriptor instead.
-func (*LoginUserResponse) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UserProject.ProtoReflect.Descriptor instead.
+func (*UserProject) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *LoginUserResponse) GetUserID() int64 {
+func (x *UserProject) GetProjectID() int64 {
 	if x != nil {
-		return x.UserID
+		return x.ProjectID
 	}
 	return 0
 }
 
-type LogoutUserRequest struct {
+func (x *UserProject) GetChainID() int64 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *UserProject) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// GetUserProjectResponse has the ids of the projects entered by the user, and the entries with their chains and addresses
+type GetUserProjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Projects []int64        `protobuf:"varint,1,rep,packed,name=projects,proto3" json:"projects,omitempty"`
+	Entries  []*UserProject `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *LogoutUserRequest) Reset() {
-	*x = LogoutUserRequest{}
+func (x *GetUserProjectResponse) Reset() {
+	*x = GetUserProjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[13]
+		mi := &file_pb_raffle_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogoutUserRequest) String() string {
+func (x *GetUserProjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutUserRequest) ProtoMessage() {}
+func (*GetUserProjectResponse) ProtoMessage() {}
 
-func (x *LogoutUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[13]
+func (x *GetUserProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,41 +1149,54 @@ func (x *LogoutUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutUserRequest.ProtoReflect.Descriptor instead.
-func (*LogoutUserRequest) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetUserProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProjectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LogoutUserRequest) GetUserID() int64 {
+func (x *GetUserProjectResponse) GetProjects() []int64 {
 	if x != nil {
-		return x.UserID
+		return x.Projects
 	}
-	return 0
+	return nil
 }
 
-type Empty struct {
+func (x *GetUserProjectResponse) GetEntries() []*UserProject {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// ProjectEntry is an entry of a project by a user
+type ProjectEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserID    int64  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ProjectID int64  `protobuf:"varint,2,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	ChainID   int64  `protobuf:"varint,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Address   string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *ProjectEntry) Reset() {
+	*x = ProjectEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[14]
+		mi := &file_pb_raffle_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Empty) String() string {
+func (x *ProjectEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*ProjectEntry) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[14]
+func (x *ProjectEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,39 +1207,70 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ProjectEntry.ProtoReflect.Descriptor instead.
+func (*ProjectEntry) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{16}
 }
 
-type Project struct {
+func (x *ProjectEntry) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ProjectEntry) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *ProjectEntry) GetChainID() int64 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *ProjectEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// ListProjectEntriesRequest lists the entries of a project. The entries are filtered by userID and address
+// (case-insensitively) if they are set
+type ListProjectEntriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectID      int64  `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
-	ProjectName    string `protobuf:"bytes,2,opt,name=projectName,proto3" json:"projectName,omitempty"`
-	ChainID        int64  `protobuf:"varint,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	RaffleContract string `protobuf:"bytes,4,opt,name=raffleContract,proto3" json:"raffleContract,omitempty"`
+	ProjectID int64  `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	UserID    int64  `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *Project) Reset() {
-	*x = Project{}
+func (x *ListProjectEntriesRequest) Reset() {
+	*x = ListProjectEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[15]
+		mi := &file_pb_raffle_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Project) String() string {
+func (x *ListProjectEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Project) ProtoMessage() {}
+func (*ListProjectEntriesRequest) ProtoMessage() {}
 
-func (x *Project) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[15]
+func (x *ListProjectEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,66 +1281,72 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Project.ProtoReflect.Descriptor instead.
-func (*Project) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListProjectEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Project) GetProjectID() int64 {
+func (x *ListProjectEntriesRequest) GetProjectID() int64 {
 	if x != nil {
 		return x.ProjectID
 	}
 	return 0
 }
 
-func (x *Project) GetProjectName() string {
+func (x *ListProjectEntriesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.ProjectName
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *Project) GetChainID() int64 {
+func (x *ListProjectEntriesRequest) GetUserID() int64 {
 	if x != nil {
-		return x.ChainID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *Project) GetRaffleContract() string {
+func (x *ListProjectEntriesRequest) GetAddress() string {
 	if x != nil {
-		return x.RaffleContract
+		return x.Address
 	}
 	return ""
 }
 
-type CreateProjectRequest struct {
+type ListProjectEntriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName    string `protobuf:"bytes,1,opt,name=projectName,proto3" json:"projectName,omitempty"`
-	ChainID        int64  `protobuf:"varint,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	RaffleContract string `protobuf:"bytes,3,opt,name=raffleContract,proto3" json:"raffleContract,omitempty"`
+	Entries       []*ProjectEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
+func (x *ListProjectEntriesResponse) Reset() {
+	*x = ListProjectEntriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[16]
+		mi := &file_pb_raffle_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateProjectRequest) String() string {
+func (x *ListProjectEntriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
+func (*ListProjectEntriesResponse) ProtoMessage() {}
 
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[16]
+func (x *ListProjectEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,57 +1357,51 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *CreateProjectRequest) GetProjectName() string {
-	if x != nil {
-		return x.ProjectName
-	}
-	return ""
+// Deprecated: Use ListProjectEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateProjectRequest) GetChainID() int64 {
+func (x *ListProjectEntriesResponse) GetEntries() []*ProjectEntry {
 	if x != nil {
-		return x.ChainID
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateProjectRequest) GetRaffleContract() string {
+func (x *ListProjectEntriesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.RaffleContract
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type CreateProjectResponse struct {
+type LoginUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectID int64 `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	UserID    string    `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	LoginType LoginType `protobuf:"varint,2,opt,name=loginType,proto3,enum=pb.LoginType" json:"loginType,omitempty"`
 }
 
-func (x *CreateProjectResponse) Reset() {
-	*x = CreateProjectResponse{}
+func (x *LoginUserRequest) Reset() {
+	*x = LoginUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[17]
+		mi := &file_pb_raffle_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateProjectResponse) String() string {
+func (x *LoginUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectResponse) ProtoMessage() {}
+func (*LoginUserRequest) ProtoMessage() {}
 
-func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[17]
+func (x *LoginUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1065,43 +1412,50 @@ func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use LoginUserRequest.ProtoReflect.Descriptor instead.
+func (*LoginUserRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateProjectResponse) GetProjectID() int64 {
+func (x *LoginUserRequest) GetUserID() string {
 	if x != nil {
-		return x.ProjectID
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-type GetProjectRequest struct {
+func (x *LoginUserRequest) GetLoginType() LoginType {
+	if x != nil {
+		return x.LoginType
+	}
+	return LoginType_DISCORD
+}
+
+type LoginUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectID int64 `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *GetProjectRequest) Reset() {
-	*x = GetProjectRequest{}
+func (x *LoginUserResponse) Reset() {
+	*x = LoginUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[18]
+		mi := &file_pb_raffle_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProjectRequest) String() string {
+func (x *LoginUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectRequest) ProtoMessage() {}
+func (*LoginUserResponse) ProtoMessage() {}
 
-func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[18]
+func (x *LoginUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,19 +1466,513 @@ func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
-func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use LoginUserResponse.ProtoReflect.Descriptor instead.
+func (*LoginUserResponse) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetProjectRequest) GetProjectID() int64 {
+func (x *LoginUserResponse) GetUserID() int64 {
 	if x != nil {
-		return x.ProjectID
+		return x.UserID
 	}
 	return 0
 }
 
-type GetProjectResponse struct {
+type LogoutUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *LogoutUserRequest) Reset() {
+	*x = LogoutUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogoutUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutUserRequest) ProtoMessage() {}
+
+func (x *LogoutUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutUserRequest.ProtoReflect.Descriptor instead.
+func (*LogoutUserRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LogoutUserRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{22}
+}
+
+type Project struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID      int64         `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	ProjectName    string        `protobuf:"bytes,2,opt,name=projectName,proto3" json:"projectName,omitempty"`
+	ChainID        int64         `protobuf:"varint,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	RaffleContract string        `protobuf:"bytes,4,opt,name=raffleContract,proto3" json:"raffleContract,omitempty"`
+	Status         ProjectStatus `protobuf:"varint,5,opt,name=status,proto3,enum=pb.ProjectStatus" json:"status,omitempty"`
+	// startTime and endTime are the window in which the project is open for the entries
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	MaxWinners int32                  `protobuf:"varint,8,opt,name=maxWinners,proto3" json:"maxWinners,omitempty"`
+	// seedCommitment is the keccak256 hash of the seed of the draw, in hex
+	SeedCommitment string `protobuf:"bytes,9,opt,name=seedCommitment,proto3" json:"seedCommitment,omitempty"`
+	// ownerID is the id of the user who created the project
+	OwnerID     int64             `protobuf:"varint,10,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	Eligibility *EligibilityRules `protobuf:"bytes,11,opt,name=eligibility,proto3" json:"eligibility,omitempty"`
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Project) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *Project) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *Project) GetChainID() int64 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *Project) GetRaffleContract() string {
+	if x != nil {
+		return x.RaffleContract
+	}
+	return ""
+}
+
+func (x *Project) GetStatus() ProjectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProjectStatus_DRAFT
+}
+
+func (x *Project) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Project) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Project) GetMaxWinners() int32 {
+	if x != nil {
+		return x.MaxWinners
+	}
+	return 0
+}
+
+func (x *Project) GetSeedCommitment() string {
+	if x != nil {
+		return x.SeedCommitment
+	}
+	return ""
+}
+
+func (x *Project) GetOwnerID() int64 {
+	if x != nil {
+		return x.OwnerID
+	}
+	return 0
+}
+
+func (x *Project) GetEligibility() *EligibilityRules {
+	if x != nil {
+		return x.Eligibility
+	}
+	return nil
+}
+
+// EligibilityRules are the rules of the users who can enter a project
+type EligibilityRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// requiredAccounts are the social accounts which the users must have linked
+	RequiredAccounts []LoginType `protobuf:"varint,1,rep,packed,name=requiredAccounts,proto3,enum=pb.LoginType" json:"requiredAccounts,omitempty"`
+	// requireWallet requires the users to have linked the wallets of the entries
+	RequireWallet bool                 `protobuf:"varint,2,opt,name=requireWallet,proto3" json:"requireWallet,omitempty"`
+	MinAccountAge *durationpb.Duration `protobuf:"bytes,3,opt,name=minAccountAge,proto3" json:"minAccountAge,omitempty"`
+}
+
+func (x *EligibilityRules) Reset() {
+	*x = EligibilityRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EligibilityRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibilityRules) ProtoMessage() {}
+
+func (x *EligibilityRules) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibilityRules.ProtoReflect.Descriptor instead.
+func (*EligibilityRules) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EligibilityRules) GetRequiredAccounts() []LoginType {
+	if x != nil {
+		return x.RequiredAccounts
+	}
+	return nil
+}
+
+func (x *EligibilityRules) GetRequireWallet() bool {
+	if x != nil {
+		return x.RequireWallet
+	}
+	return false
+}
+
+func (x *EligibilityRules) GetMinAccountAge() *durationpb.Duration {
+	if x != nil {
+		return x.MinAccountAge
+	}
+	return nil
+}
+
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectName    string                 `protobuf:"bytes,1,opt,name=projectName,proto3" json:"projectName,omitempty"`
+	ChainID        int64                  `protobuf:"varint,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	RaffleContract string                 `protobuf:"bytes,3,opt,name=raffleContract,proto3" json:"raffleContract,omitempty"`
+	Status         ProjectStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=pb.ProjectStatus" json:"status,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	MaxWinners     int32                  `protobuf:"varint,7,opt,name=maxWinners,proto3" json:"maxWinners,omitempty"`
+	SeedCommitment string                 `protobuf:"bytes,8,opt,name=seedCommitment,proto3" json:"seedCommitment,omitempty"`
+	OwnerID        int64                  `protobuf:"varint,9,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	Eligibility    *EligibilityRules      `protobuf:"bytes,10,opt,name=eligibility,proto3" json:"eligibility,omitempty"`
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateProjectRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetChainID() int64 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *CreateProjectRequest) GetRaffleContract() string {
+	if x != nil {
+		return x.RaffleContract
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetStatus() ProjectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProjectStatus_DRAFT
+}
+
+func (x *CreateProjectRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetMaxWinners() int32 {
+	if x != nil {
+		return x.MaxWinners
+	}
+	return 0
+}
+
+func (x *CreateProjectRequest) GetSeedCommitment() string {
+	if x != nil {
+		return x.SeedCommitment
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetOwnerID() int64 {
+	if x != nil {
+		return x.OwnerID
+	}
+	return 0
+}
+
+func (x *CreateProjectRequest) GetEligibility() *EligibilityRules {
+	if x != nil {
+		return x.Eligibility
+	}
+	return nil
+}
+
+type CreateProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID int64 `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+}
+
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectResponse) ProtoMessage() {}
+
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateProjectResponse) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID int64 `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetProjectRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1132,23 +1980,1066 @@ type GetProjectResponse struct {
 	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 }
 
-func (x *GetProjectResponse) Reset() {
-	*x = GetProjectResponse{}
+func (x *GetProjectResponse) Reset() {
+	*x = GetProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectResponse) ProtoMessage() {}
+
+func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type GetAllProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *GetAllProjectResponse) Reset() {
+	*x = GetAllProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllProjectResponse) ProtoMessage() {}
+
+func (x *GetAllProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetAllProjectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAllProjectResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+// ListProjectsRequest lists the projects page by page.
+// sort is one of "id" (default), "-id", "name" and "-name", where "-" means the descending order
+type ListProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainID   int64  `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sort      string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	PageSize  int32  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListProjectsRequest) GetChainID() int64 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *ListProjectsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListProjectsResponse has a page of the projects. nextPageToken is empty on the last page
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Projects      []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *ListProjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateProjectRequest updates the fields of the project in updateMask (e.g., "projectName,chainID") to those of project.
+// Paths of nested fields (e.g., "eligibility.requireWallet") update only the nested fields.
+// All the fields are replaced if updateMask is empty
+type UpdateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID  int64                  `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	Project    *Project               `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateProjectRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *UpdateProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DrawProof is what the winners of a draw are computed from, with the seed
+type DrawProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scheme         string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	SeedCommitment string `protobuf:"bytes,2,opt,name=seedCommitment,proto3" json:"seedCommitment,omitempty"`
+	// entriesHash is the keccak256 hash of the entries in the draw, in hex
+	EntriesHash string `protobuf:"bytes,3,opt,name=entriesHash,proto3" json:"entriesHash,omitempty"`
+	EntryCount  int64  `protobuf:"varint,4,opt,name=entryCount,proto3" json:"entryCount,omitempty"`
+	// randomness is the keccak256 hash of the seed, blockHash and entriesHash, in hex
+	Randomness string `protobuf:"bytes,5,opt,name=randomness,proto3" json:"randomness,omitempty"`
+	// blockNumber is the first block on the chain of the project after its endTime, whose hash is unknown until the
+	// project closes. blockHash is its hash, in hex
+	BlockNumber int64  `protobuf:"varint,6,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	BlockHash   string `protobuf:"bytes,7,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+}
+
+func (x *DrawProof) Reset() {
+	*x = DrawProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawProof) ProtoMessage() {}
+
+func (x *DrawProof) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawProof.ProtoReflect.Descriptor instead.
+func (*DrawProof) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DrawProof) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *DrawProof) GetSeedCommitment() string {
+	if x != nil {
+		return x.SeedCommitment
+	}
+	return ""
+}
+
+func (x *DrawProof) GetEntriesHash() string {
+	if x != nil {
+		return x.EntriesHash
+	}
+	return ""
+}
+
+func (x *DrawProof) GetEntryCount() int64 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+func (x *DrawProof) GetRandomness() string {
+	if x != nil {
+		return x.Randomness
+	}
+	return ""
+}
+
+func (x *DrawProof) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *DrawProof) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+// ProjectDraw is the result of the draw of a project
+type ProjectDraw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID int64 `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	// seed is the revealed seed of the draw, in hex
+	Seed    string                 `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Proof   *DrawProof             `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	Winners []*ProjectEntry        `protobuf:"bytes,4,rep,name=winners,proto3" json:"winners,omitempty"`
+	DrawnAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=drawnAt,proto3" json:"drawnAt,omitempty"`
+}
+
+func (x *ProjectDraw) Reset() {
+	*x = ProjectDraw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectDraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectDraw) ProtoMessage() {}
+
+func (x *ProjectDraw) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectDraw.ProtoReflect.Descriptor instead.
+func (*ProjectDraw) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ProjectDraw) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *ProjectDraw) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *ProjectDraw) GetProof() *DrawProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *ProjectDraw) GetWinners() []*ProjectEntry {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+func (x *ProjectDraw) GetDrawnAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DrawnAt
+	}
+	return nil
+}
+
+// ProjectMember is a user granted a role in a project. The owner of a project is not a member, but its ownerID
+type ProjectMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID int64       `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	UserID    int64       `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Role      ProjectRole `protobuf:"varint,3,opt,name=role,proto3,enum=pb.ProjectRole" json:"role,omitempty"`
+}
+
+func (x *ProjectMember) Reset() {
+	*x = ProjectMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMember) ProtoMessage() {}
+
+func (x *ProjectMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMember.ProtoReflect.Descriptor instead.
+func (*ProjectMember) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProjectMember) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *ProjectMember) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ProjectMember) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_VIEWER
+}
+
+type ListProjectMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID int64 `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+}
+
+func (x *ListProjectMembersRequest) Reset() {
+	*x = ListProjectMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersRequest) ProtoMessage() {}
+
+func (x *ListProjectMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListProjectMembersRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+type ListProjectMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*ProjectMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *ListProjectMembersResponse) Reset() {
+	*x = ListProjectMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersResponse) ProtoMessage() {}
+
+func (x *ListProjectMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersResponse) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListProjectMembersResponse) GetMembers() []*ProjectMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type DeleteProjectMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID int64 `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	UserID    int64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *DeleteProjectMemberRequest) Reset() {
+	*x = DeleteProjectMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectMemberRequest) ProtoMessage() {}
+
+func (x *DeleteProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteProjectMemberRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *DeleteProjectMemberRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type CreateProjectDrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draw *ProjectDraw `protobuf:"bytes,1,opt,name=draw,proto3" json:"draw,omitempty"`
+}
+
+func (x *CreateProjectDrawRequest) Reset() {
+	*x = CreateProjectDrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProjectDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectDrawRequest) ProtoMessage() {}
+
+func (x *CreateProjectDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectDrawRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectDrawRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateProjectDrawRequest) GetDraw() *ProjectDraw {
+	if x != nil {
+		return x.Draw
+	}
+	return nil
+}
+
+type GetProjectDrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID int64 `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+}
+
+func (x *GetProjectDrawRequest) Reset() {
+	*x = GetProjectDrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectDrawRequest) ProtoMessage() {}
+
+func (x *GetProjectDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectDrawRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectDrawRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetProjectDrawRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+// ErrorDetail is an error of a part of a response. code is the name of the grpc status code, e.g., NOT_FOUND
+type ErrorDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ErrorDetail) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ErrorDetail) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UserProjectDetail is an entry of the user with its project, or the error of getting the project
+type UserProjectDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectID int64        `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	ChainID   int64        `protobuf:"varint,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Address   string       `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Project   *Project     `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	Error     *ErrorDetail `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *UserProjectDetail) Reset() {
+	*x = UserProjectDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserProjectDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProjectDetail) ProtoMessage() {}
+
+func (x *UserProjectDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProjectDetail.ProtoReflect.Descriptor instead.
+func (*UserProjectDetail) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UserProjectDetail) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *UserProjectDetail) GetChainID() int64 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *UserProjectDetail) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UserProjectDetail) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *UserProjectDetail) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetUserProjectDetailsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Projects []*UserProjectDetail `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *GetUserProjectDetailsResponse) Reset() {
+	*x = GetUserProjectDetailsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserProjectDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProjectDetailsResponse) ProtoMessage() {}
+
+func (x *GetUserProjectDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProjectDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProjectDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetUserProjectDetailsResponse) GetProjects() []*UserProjectDetail {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+// UserProfile is a user with the wallets and the entries. errors has the errors of the sections (user, wallets and projects)
+// which cannot be fetched
+type UserProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User     *GetUserResponse        `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Wallets  []*UserWallet           `protobuf:"bytes,2,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	Projects []*UserProjectDetail    `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
+	Errors   map[string]*ErrorDetail `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UserProfile) GetUser() *GetUserResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserProfile) GetWallets() []*UserWallet {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *UserProfile) GetProjects() []*UserProjectDetail {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *UserProfile) GetErrors() map[string]*ErrorDetail {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// DrawProjectRequest is the body of drawing a project, with the revealed seed and the number of the block of the draw
+type DrawProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed        string `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	BlockNumber int64  `protobuf:"varint,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+}
+
+func (x *DrawProjectRequest) Reset() {
+	*x = DrawProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[19]
+		mi := &file_pb_raffle_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProjectResponse) String() string {
+func (x *DrawProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectResponse) ProtoMessage() {}
+func (*DrawProjectRequest) ProtoMessage() {}
 
-func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[19]
+func (x *DrawProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,43 +3050,125 @@ func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
-func (*GetProjectResponse) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DrawProjectRequest.ProtoReflect.Descriptor instead.
+func (*DrawProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetProjectResponse) GetProject() *Project {
+func (x *DrawProjectRequest) GetSeed() string {
 	if x != nil {
-		return x.Project
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *DrawProjectRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+// DrawVerification is the result of recomputing a draw. mismatches has the parts of the draw which are not reproduced
+type DrawVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verified   bool            `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	Mismatches []string        `protobuf:"bytes,2,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+	Proof      *DrawProof      `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	Winners    []*ProjectEntry `protobuf:"bytes,4,rep,name=winners,proto3" json:"winners,omitempty"`
+}
+
+func (x *DrawVerification) Reset() {
+	*x = DrawVerification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_raffle_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawVerification) ProtoMessage() {}
+
+func (x *DrawVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawVerification.ProtoReflect.Descriptor instead.
+func (*DrawVerification) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DrawVerification) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *DrawVerification) GetMismatches() []string {
+	if x != nil {
+		return x.Mismatches
 	}
 	return nil
 }
 
-type GetAllProjectResponse struct {
+func (x *DrawVerification) GetProof() *DrawProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *DrawVerification) GetWinners() []*ProjectEntry {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+// Allowlist is the merkle tree of the addresses of the winners of a project. root is in hex
+type Allowlist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	ProjectID int64    `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	Root      string   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (x *GetAllProjectResponse) Reset() {
-	*x = GetAllProjectResponse{}
+func (x *Allowlist) Reset() {
+	*x = Allowlist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[20]
+		mi := &file_pb_raffle_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllProjectResponse) String() string {
+func (x *Allowlist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllProjectResponse) ProtoMessage() {}
+func (*Allowlist) ProtoMessage() {}
 
-func (x *GetAllProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[20]
+func (x *Allowlist) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1206,43 +3179,62 @@ func (x *GetAllProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllProjectResponse.ProtoReflect.Descriptor instead.
-func (*GetAllProjectResponse) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Allowlist.ProtoReflect.Descriptor instead.
+func (*Allowlist) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetAllProjectResponse) GetProjects() []*Project {
+func (x *Allowlist) GetProjectID() int64 {
 	if x != nil {
-		return x.Projects
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *Allowlist) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *Allowlist) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
 	}
 	return nil
 }
 
-type UpdateProjectRequest struct {
+// AllowlistProof is the merkle proof of an address in the allowlist of a project. leaf and proof are in hex
+type AllowlistProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectID int64 `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	ProjectID int64    `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	Root      string   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Address   string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Leaf      string   `protobuf:"bytes,4,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Proof     []string `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (x *UpdateProjectRequest) Reset() {
-	*x = UpdateProjectRequest{}
+func (x *AllowlistProof) Reset() {
+	*x = AllowlistProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_raffle_proto_msgTypes[21]
+		mi := &file_pb_raffle_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProjectRequest) String() string {
+func (x *AllowlistProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectRequest) ProtoMessage() {}
+func (*AllowlistProof) ProtoMessage() {}
 
-func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_raffle_proto_msgTypes[21]
+func (x *AllowlistProof) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_raffle_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,188 +3245,580 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pb_raffle_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AllowlistProof.ProtoReflect.Descriptor instead.
+func (*AllowlistProof) Descriptor() ([]byte, []int) {
+	return file_pb_raffle_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UpdateProjectRequest) GetProjectID() int64 {
+func (x *AllowlistProof) GetProjectID() int64 {
 	if x != nil {
 		return x.ProjectID
 	}
 	return 0
 }
 
+func (x *AllowlistProof) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *AllowlistProof) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AllowlistProof) GetLeaf() string {
+	if x != nil {
+		return x.Leaf
+	}
+	return ""
+}
+
+func (x *AllowlistProof) GetProof() []string {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
 var File_pb_raffle_proto protoreflect.FileDescriptor
 
 var file_pb_raffle_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x70, 0x62, 0x2f, 0x72, 0x61, 0x66, 0x66, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x58, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x2b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xb2, 0x01, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
-	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0x87,
-	0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
-	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x77,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x06, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
-	0x0a, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
-	0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xec, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x72, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x7d,
+	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x65, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65,
 	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0x34, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x84, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x50, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x5f, 0x0a, 0x0b, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5f, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x78, 0x0a,
+	0x0c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x6e, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x57, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x09, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55,
 	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x2b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0x2b, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x11,
-	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x8b, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xc0, 0x03, 0x0a, 0x07,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x44, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x61, 0x66, 0x66, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x61, 0x66, 0x66, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65,
+	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xb4,
+	0x01, 0x0a, 0x10, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x41, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x41, 0x67, 0x65, 0x22, 0xaf, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x61,
+	0x66, 0x66, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x61, 0x66, 0x66, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x73, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x36, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x35, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x22, 0x31,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x44, 0x22, 0x3b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x40,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x22, 0x91, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x65, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x44, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xed, 0x01, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x77, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x65, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e,
+	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0xc6, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x44, 0x72, 0x61, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x72, 0x61, 0x77,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2a, 0x0a, 0x07,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x72, 0x61, 0x77,
+	0x6e, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x41, 0x74, 0x22, 0x6a,
+	0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x39, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x44, 0x22, 0x49, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x22, 0x52, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
 	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x61, 0x66, 0x66,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x72, 0x61, 0x66, 0x66, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x22, 0x7a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x61, 0x66, 0x66, 0x6c, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x61,
-	0x66, 0x66, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x35, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x3f, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x04, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x72, 0x61, 0x77, 0x52,
+	0x04, 0x64, 0x72, 0x61, 0x77, 0x22, 0x35, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x22, 0x3b, 0x0a, 0x0b,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x52, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x07,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x07, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x4a,
+	0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4a, 0x0a, 0x12, 0x44, 0x72,
+	0x61, 0x77, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x65, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x77, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x72, 0x61, 0x77,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2a, 0x0a, 0x07,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a,
 	0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x22, 0x3b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x22, 0x40, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x2a, 0x33, 0x0a, 0x09, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x43,
-	0x4f, 0x52, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x45, 0x4c, 0x45, 0x47, 0x52, 0x41,
-	0x4d, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x57, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10, 0x02,
-	0x32, 0xbc, 0x04, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x3c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2a, 0x33,
+	0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44,
+	0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x45, 0x4c, 0x45,
+	0x47, 0x52, 0x41, 0x4d, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x57, 0x49, 0x54, 0x54, 0x45,
+	0x52, 0x10, 0x02, 0x2a, 0x58, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x55, 0x50, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x52, 0x41, 0x57, 0x4e, 0x10, 0x04, 0x12, 0x0d,
+	0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x2f, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06,
+	0x56, 0x49, 0x45, 0x57, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49,
+	0x4e, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x32, 0xd1,
+	0x0a, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f,
+	0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x64, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x68,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12,
+	0x17, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
 	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x3e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x3a, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14,
-	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a,
-	0x0a, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62,
-	0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x32,
-	0x96, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x09, 0x2e, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x1a, 0x0e, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x22, 0x1c, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x3a,
+	0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x8f, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x48, 0x32, 0x3e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f,
+	0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x7d,
+	0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x7d, 0x3a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x6d, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x7d, 0x2f, 0x7b,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x5f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x6b, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x1a,
+	0x22, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x44, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x2a, 0x22, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x7d,
+	0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0a, 0x22, 0x05, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x4d, 0x0a, 0x0a,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x22, 0x15, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x7d, 0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x55, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x32, 0xbf, 0x06, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0d, 0x22, 0x08, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x3a, 0x01, 0x2a,
+	0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x15,
 	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x19, 0x5a, 0x17, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x65, 0x72, 0x61, 0x66, 0x66, 0x6c, 0x65,
-	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f,
+	0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x38, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x09, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b,
+	0x12, 0x09, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x0d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x1a, 0x14, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x7d, 0x3a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5a, 0x1f, 0x32, 0x14, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x7d, 0x3a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x44, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x72, 0x61, 0x77, 0x12, 0x1c, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x72, 0x61, 0x77, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x72, 0x61, 0x77, 0x12,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x72, 0x61, 0x77, 0x22, 0x00, 0x12, 0x55, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x42, 0x19, 0x5a, 0x17, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x65, 0x72, 0x61, 0x66, 0x66, 0x6c, 0x65, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1449,72 +3833,161 @@ func file_pb_raffle_proto_rawDescGZIP() []byte {
 	return file_pb_raffle_proto_rawDescData
 }
 
-var file_pb_raffle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pb_raffle_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_pb_raffle_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pb_raffle_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_pb_raffle_proto_goTypes = []interface{}{
-	(LoginType)(0),                   // 0: pb.LoginType
-	(*CreateUserRequest)(nil),        // 1: pb.CreateUserRequest
-	(*GetUserRequest)(nil),           // 2: pb.GetUserRequest
-	(*GetUserResponse)(nil),          // 3: pb.GetUserResponse
-	(*UpdateUserRequest)(nil),        // 4: pb.UpdateUserRequest
-	(*UserWallet)(nil),               // 5: pb.UserWallet
-	(*CreateUserWalletRequest)(nil),  // 6: pb.CreateUserWalletRequest
-	(*GetUserWalletRequest)(nil),     // 7: pb.GetUserWalletRequest
-	(*GetUserWalletResponse)(nil),    // 8: pb.GetUserWalletResponse
-	(*CreateUserProjectRequest)(nil), // 9: pb.CreateUserProjectRequest
-	(*GetUserProjectRequest)(nil),    // 10: pb.GetUserProjectRequest
-	(*GetUserProjectResponse)(nil),   // 11: pb.GetUserProjectResponse
-	(*LoginUserRequest)(nil),         // 12: pb.LoginUserRequest
-	(*LoginUserResponse)(nil),        // 13: pb.LoginUserResponse
-	(*LogoutUserRequest)(nil),        // 14: pb.LogoutUserRequest
-	(*Empty)(nil),                    // 15: pb.Empty
-	(*Project)(nil),                  // 16: pb.Project
-	(*CreateProjectRequest)(nil),     // 17: pb.CreateProjectRequest
-	(*CreateProjectResponse)(nil),    // 18: pb.CreateProjectResponse
-	(*GetProjectRequest)(nil),        // 19: pb.GetProjectRequest
-	(*GetProjectResponse)(nil),       // 20: pb.GetProjectResponse
-	(*GetAllProjectResponse)(nil),    // 21: pb.GetAllProjectResponse
-	(*UpdateProjectRequest)(nil),     // 22: pb.UpdateProjectRequest
+	(LoginType)(0),                        // 0: pb.LoginType
+	(ProjectStatus)(0),                    // 1: pb.ProjectStatus
+	(ProjectRole)(0),                      // 2: pb.ProjectRole
+	(*CreateUserRequest)(nil),             // 3: pb.CreateUserRequest
+	(*GetUserRequest)(nil),                // 4: pb.GetUserRequest
+	(*GetUserResponse)(nil),               // 5: pb.GetUserResponse
+	(*UpdateUserRequest)(nil),             // 6: pb.UpdateUserRequest
+	(*UserWallet)(nil),                    // 7: pb.UserWallet
+	(*UpdateUserWalletRequest)(nil),       // 8: pb.UpdateUserWalletRequest
+	(*DeleteUserWalletRequest)(nil),       // 9: pb.DeleteUserWalletRequest
+	(*CreateUserWalletRequest)(nil),       // 10: pb.CreateUserWalletRequest
+	(*GetUserWalletRequest)(nil),          // 11: pb.GetUserWalletRequest
+	(*GetUserWalletResponse)(nil),         // 12: pb.GetUserWalletResponse
+	(*CreateUserProjectRequest)(nil),      // 13: pb.CreateUserProjectRequest
+	(*UpdateUserProjectRequest)(nil),      // 14: pb.UpdateUserProjectRequest
+	(*DeleteUserProjectRequest)(nil),      // 15: pb.DeleteUserProjectRequest
+	(*GetUserProjectRequest)(nil),         // 16: pb.GetUserProjectRequest
+	(*UserProject)(nil),                   // 17: pb.UserProject
+	(*GetUserProjectResponse)(nil),        // 18: pb.GetUserProjectResponse
+	(*ProjectEntry)(nil),                  // 19: pb.ProjectEntry
+	(*ListProjectEntriesRequest)(nil),     // 20: pb.ListProjectEntriesRequest
+	(*ListProjectEntriesResponse)(nil),    // 21: pb.ListProjectEntriesResponse
+	(*LoginUserRequest)(nil),              // 22: pb.LoginUserRequest
+	(*LoginUserResponse)(nil),             // 23: pb.LoginUserResponse
+	(*LogoutUserRequest)(nil),             // 24: pb.LogoutUserRequest
+	(*Empty)(nil),                         // 25: pb.Empty
+	(*Project)(nil),                       // 26: pb.Project
+	(*EligibilityRules)(nil),              // 27: pb.EligibilityRules
+	(*CreateProjectRequest)(nil),          // 28: pb.CreateProjectRequest
+	(*CreateProjectResponse)(nil),         // 29: pb.CreateProjectResponse
+	(*GetProjectRequest)(nil),             // 30: pb.GetProjectRequest
+	(*GetProjectResponse)(nil),            // 31: pb.GetProjectResponse
+	(*GetAllProjectResponse)(nil),         // 32: pb.GetAllProjectResponse
+	(*ListProjectsRequest)(nil),           // 33: pb.ListProjectsRequest
+	(*ListProjectsResponse)(nil),          // 34: pb.ListProjectsResponse
+	(*UpdateProjectRequest)(nil),          // 35: pb.UpdateProjectRequest
+	(*DrawProof)(nil),                     // 36: pb.DrawProof
+	(*ProjectDraw)(nil),                   // 37: pb.ProjectDraw
+	(*ProjectMember)(nil),                 // 38: pb.ProjectMember
+	(*ListProjectMembersRequest)(nil),     // 39: pb.ListProjectMembersRequest
+	(*ListProjectMembersResponse)(nil),    // 40: pb.ListProjectMembersResponse
+	(*DeleteProjectMemberRequest)(nil),    // 41: pb.DeleteProjectMemberRequest
+	(*CreateProjectDrawRequest)(nil),      // 42: pb.CreateProjectDrawRequest
+	(*GetProjectDrawRequest)(nil),         // 43: pb.GetProjectDrawRequest
+	(*ErrorDetail)(nil),                   // 44: pb.ErrorDetail
+	(*UserProjectDetail)(nil),             // 45: pb.UserProjectDetail
+	(*GetUserProjectDetailsResponse)(nil), // 46: pb.GetUserProjectDetailsResponse
+	(*UserProfile)(nil),                   // 47: pb.UserProfile
+	(*DrawProjectRequest)(nil),            // 48: pb.DrawProjectRequest
+	(*DrawVerification)(nil),              // 49: pb.DrawVerification
+	(*Allowlist)(nil),                     // 50: pb.Allowlist
+	(*AllowlistProof)(nil),                // 51: pb.AllowlistProof
+	nil,                                   // 52: pb.UserProfile.ErrorsEntry
+	(*timestamppb.Timestamp)(nil),         // 53: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 54: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),           // 55: google.protobuf.Duration
 }
 var file_pb_raffle_proto_depIdxs = []int32{
 	0,  // 0: pb.CreateUserRequest.loginType:type_name -> pb.LoginType
 	0,  // 1: pb.GetUserResponse.loginType:type_name -> pb.LoginType
-	5,  // 2: pb.CreateUserWalletRequest.wallet:type_name -> pb.UserWallet
-	5,  // 3: pb.GetUserWalletResponse.wallets:type_name -> pb.UserWallet
-	0,  // 4: pb.LoginUserRequest.loginType:type_name -> pb.LoginType
-	16, // 5: pb.GetProjectResponse.project:type_name -> pb.Project
-	16, // 6: pb.GetAllProjectResponse.projects:type_name -> pb.Project
-	1,  // 7: pb.UserService.CreateUser:input_type -> pb.CreateUserRequest
-	2,  // 8: pb.UserService.GetUser:input_type -> pb.GetUserRequest
-	7,  // 9: pb.UserService.GetUserWallet:input_type -> pb.GetUserWalletRequest
-	10, // 10: pb.UserService.GetUserProject:input_type -> pb.GetUserProjectRequest
-	4,  // 11: pb.UserService.UpdateUser:input_type -> pb.UpdateUserRequest
-	6,  // 12: pb.UserService.CreateUserWallet:input_type -> pb.CreateUserWalletRequest
-	9,  // 13: pb.UserService.CreateUserProject:input_type -> pb.CreateUserProjectRequest
-	12, // 14: pb.UserService.LoginUser:input_type -> pb.LoginUserRequest
-	14, // 15: pb.UserService.LogoutUser:input_type -> pb.LogoutUserRequest
-	17, // 16: pb.ProjectService.CreateProject:input_type -> pb.CreateProjectRequest
-	19, // 17: pb.ProjectService.GetProject:input_type -> pb.GetProjectRequest
-	15, // 18: pb.ProjectService.GetAllProjects:input_type -> pb.Empty
-	22, // 19: pb.ProjectService.UpdateProject:input_type -> pb.UpdateProjectRequest
-	13, // 20: pb.UserService.CreateUser:output_type -> pb.LoginUserResponse
-	3,  // 21: pb.UserService.GetUser:output_type -> pb.GetUserResponse
-	8,  // 22: pb.UserService.GetUserWallet:output_type -> pb.GetUserWalletResponse
-	11, // 23: pb.UserService.GetUserProject:output_type -> pb.GetUserProjectResponse
-	3,  // 24: pb.UserService.UpdateUser:output_type -> pb.GetUserResponse
-	15, // 25: pb.UserService.CreateUserWallet:output_type -> pb.Empty
-	15, // 26: pb.UserService.CreateUserProject:output_type -> pb.Empty
-	13, // 27: pb.UserService.LoginUser:output_type -> pb.LoginUserResponse
-	15, // 28: pb.UserService.LogoutUser:output_type -> pb.Empty
-	18, // 29: pb.ProjectService.CreateProject:output_type -> pb.CreateProjectResponse
-	20, // 30: pb.ProjectService.GetProject:output_type -> pb.GetProjectResponse
-	21, // 31: pb.ProjectService.GetAllProjects:output_type -> pb.GetAllProjectResponse
-	20, // 32: pb.ProjectService.UpdateProject:output_type -> pb.GetProjectResponse
-	20, // [20:33] is the sub-list for method output_type
-	7,  // [7:20] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	53, // 2: pb.GetUserResponse.createdAt:type_name -> google.protobuf.Timestamp
+	7,  // 3: pb.UpdateUserWalletRequest.wallet:type_name -> pb.UserWallet
+	54, // 4: pb.UpdateUserWalletRequest.updateMask:type_name -> google.protobuf.FieldMask
+	7,  // 5: pb.CreateUserWalletRequest.wallet:type_name -> pb.UserWallet
+	7,  // 6: pb.GetUserWalletResponse.wallets:type_name -> pb.UserWallet
+	17, // 7: pb.GetUserProjectResponse.entries:type_name -> pb.UserProject
+	19, // 8: pb.ListProjectEntriesResponse.entries:type_name -> pb.ProjectEntry
+	0,  // 9: pb.LoginUserRequest.loginType:type_name -> pb.LoginType
+	1,  // 10: pb.Project.status:type_name -> pb.ProjectStatus
+	53, // 11: pb.Project.startTime:type_name -> google.protobuf.Timestamp
+	53, // 12: pb.Project.endTime:type_name -> google.protobuf.Timestamp
+	27, // 13: pb.Project.eligibility:type_name -> pb.EligibilityRules
+	0,  // 14: pb.EligibilityRules.requiredAccounts:type_name -> pb.LoginType
+	55, // 15: pb.EligibilityRules.minAccountAge:type_name -> google.protobuf.Duration
+	1,  // 16: pb.CreateProjectRequest.status:type_name -> pb.ProjectStatus
+	53, // 17: pb.CreateProjectRequest.startTime:type_name -> google.protobuf.Timestamp
+	53, // 18: pb.CreateProjectRequest.endTime:type_name -> google.protobuf.Timestamp
+	27, // 19: pb.CreateProjectRequest.eligibility:type_name -> pb.EligibilityRules
+	26, // 20: pb.GetProjectResponse.project:type_name -> pb.Project
+	26, // 21: pb.GetAllProjectResponse.projects:type_name -> pb.Project
+	26, // 22: pb.ListProjectsResponse.projects:type_name -> pb.Project
+	26, // 23: pb.UpdateProjectRequest.project:type_name -> pb.Project
+	54, // 24: pb.UpdateProjectRequest.updateMask:type_name -> google.protobuf.FieldMask
+	36, // 25: pb.ProjectDraw.proof:type_name -> pb.DrawProof
+	19, // 26: pb.ProjectDraw.winners:type_name -> pb.ProjectEntry
+	53, // 27: pb.ProjectDraw.drawnAt:type_name -> google.protobuf.Timestamp
+	2,  // 28: pb.ProjectMember.role:type_name -> pb.ProjectRole
+	38, // 29: pb.ListProjectMembersResponse.members:type_name -> pb.ProjectMember
+	37, // 30: pb.CreateProjectDrawRequest.draw:type_name -> pb.ProjectDraw
+	26, // 31: pb.UserProjectDetail.project:type_name -> pb.Project
+	44, // 32: pb.UserProjectDetail.error:type_name -> pb.ErrorDetail
+	45, // 33: pb.GetUserProjectDetailsResponse.projects:type_name -> pb.UserProjectDetail
+	5,  // 34: pb.UserProfile.user:type_name -> pb.GetUserResponse
+	7,  // 35: pb.UserProfile.wallets:type_name -> pb.UserWallet
+	45, // 36: pb.UserProfile.projects:type_name -> pb.UserProjectDetail
+	52, // 37: pb.UserProfile.errors:type_name -> pb.UserProfile.ErrorsEntry
+	36, // 38: pb.DrawVerification.proof:type_name -> pb.DrawProof
+	19, // 39: pb.DrawVerification.winners:type_name -> pb.ProjectEntry
+	44, // 40: pb.UserProfile.ErrorsEntry.value:type_name -> pb.ErrorDetail
+	3,  // 41: pb.UserService.CreateUser:input_type -> pb.CreateUserRequest
+	4,  // 42: pb.UserService.GetUser:input_type -> pb.GetUserRequest
+	11, // 43: pb.UserService.GetUserWallet:input_type -> pb.GetUserWalletRequest
+	16, // 44: pb.UserService.GetUserProject:input_type -> pb.GetUserProjectRequest
+	6,  // 45: pb.UserService.UpdateUser:input_type -> pb.UpdateUserRequest
+	10, // 46: pb.UserService.CreateUserWallet:input_type -> pb.CreateUserWalletRequest
+	8,  // 47: pb.UserService.UpdateUserWallet:input_type -> pb.UpdateUserWalletRequest
+	9,  // 48: pb.UserService.DeleteUserWallet:input_type -> pb.DeleteUserWalletRequest
+	13, // 49: pb.UserService.CreateUserProject:input_type -> pb.CreateUserProjectRequest
+	14, // 50: pb.UserService.UpdateUserProject:input_type -> pb.UpdateUserProjectRequest
+	15, // 51: pb.UserService.DeleteUserProject:input_type -> pb.DeleteUserProjectRequest
+	22, // 52: pb.UserService.LoginUser:input_type -> pb.LoginUserRequest
+	24, // 53: pb.UserService.LogoutUser:input_type -> pb.LogoutUserRequest
+	20, // 54: pb.UserService.ListProjectEntries:input_type -> pb.ListProjectEntriesRequest
+	28, // 55: pb.ProjectService.CreateProject:input_type -> pb.CreateProjectRequest
+	30, // 56: pb.ProjectService.GetProject:input_type -> pb.GetProjectRequest
+	25, // 57: pb.ProjectService.GetAllProjects:input_type -> pb.Empty
+	33, // 58: pb.ProjectService.ListProjects:input_type -> pb.ListProjectsRequest
+	35, // 59: pb.ProjectService.UpdateProject:input_type -> pb.UpdateProjectRequest
+	42, // 60: pb.ProjectService.CreateProjectDraw:input_type -> pb.CreateProjectDrawRequest
+	43, // 61: pb.ProjectService.GetProjectDraw:input_type -> pb.GetProjectDrawRequest
+	39, // 62: pb.ProjectService.ListProjectMembers:input_type -> pb.ListProjectMembersRequest
+	38, // 63: pb.ProjectService.SetProjectMember:input_type -> pb.ProjectMember
+	41, // 64: pb.ProjectService.DeleteProjectMember:input_type -> pb.DeleteProjectMemberRequest
+	23, // 65: pb.UserService.CreateUser:output_type -> pb.LoginUserResponse
+	5,  // 66: pb.UserService.GetUser:output_type -> pb.GetUserResponse
+	12, // 67: pb.UserService.GetUserWallet:output_type -> pb.GetUserWalletResponse
+	18, // 68: pb.UserService.GetUserProject:output_type -> pb.GetUserProjectResponse
+	5,  // 69: pb.UserService.UpdateUser:output_type -> pb.GetUserResponse
+	25, // 70: pb.UserService.CreateUserWallet:output_type -> pb.Empty
+	7,  // 71: pb.UserService.UpdateUserWallet:output_type -> pb.UserWallet
+	25, // 72: pb.UserService.DeleteUserWallet:output_type -> pb.Empty
+	25, // 73: pb.UserService.CreateUserProject:output_type -> pb.Empty
+	25, // 74: pb.UserService.UpdateUserProject:output_type -> pb.Empty
+	25, // 75: pb.UserService.DeleteUserProject:output_type -> pb.Empty
+	23, // 76: pb.UserService.LoginUser:output_type -> pb.LoginUserResponse
+	25, // 77: pb.UserService.LogoutUser:output_type -> pb.Empty
+	21, // 78: pb.UserService.ListProjectEntries:output_type -> pb.ListProjectEntriesResponse
+	29, // 79: pb.ProjectService.CreateProject:output_type -> pb.CreateProjectResponse
+	31, // 80: pb.ProjectService.GetProject:output_type -> pb.GetProjectResponse
+	32, // 81: pb.ProjectService.GetAllProjects:output_type -> pb.GetAllProjectResponse
+	34, // 82: pb.ProjectService.ListProjects:output_type -> pb.ListProjectsResponse
+	31, // 83: pb.ProjectService.UpdateProject:output_type -> pb.GetProjectResponse
+	37, // 84: pb.ProjectService.CreateProjectDraw:output_type -> pb.ProjectDraw
+	37, // 85: pb.ProjectService.GetProjectDraw:output_type -> pb.ProjectDraw
+	40, // 86: pb.ProjectService.ListProjectMembers:output_type -> pb.ListProjectMembersResponse
+	38, // 87: pb.ProjectService.SetProjectMember:output_type -> pb.ProjectMember
+	25, // 88: pb.ProjectService.DeleteProjectMember:output_type -> pb.Empty
+	65, // [65:89] is the sub-list for method output_type
+	41, // [41:65] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_pb_raffle_proto_init() }
@@ -1535,8 +4008,92 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRequest); i {
+		file_pb_raffle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserWallet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserWalletRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1547,8 +4104,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserResponse); i {
+		file_pb_raffle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserWalletResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1559,8 +4116,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserRequest); i {
+		file_pb_raffle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1571,8 +4128,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserWallet); i {
+		file_pb_raffle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1583,8 +4140,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserWalletRequest); i {
+		file_pb_raffle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1595,8 +4152,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserWalletRequest); i {
+		file_pb_raffle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1607,8 +4164,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserWalletResponse); i {
+		file_pb_raffle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserProject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1619,8 +4176,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserProjectRequest); i {
+		file_pb_raffle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1631,8 +4188,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserProjectRequest); i {
+		file_pb_raffle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1643,8 +4200,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserProjectResponse); i {
+		file_pb_raffle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1655,7 +4212,19 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_raffle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginUserRequest); i {
 			case 0:
 				return &v.state
@@ -1667,7 +4236,7 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_raffle_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginUserResponse); i {
 			case 0:
 				return &v.state
@@ -1679,7 +4248,7 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_raffle_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogoutUserRequest); i {
 			case 0:
 				return &v.state
@@ -1691,7 +4260,7 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_raffle_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -1703,7 +4272,7 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_raffle_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Project); i {
 			case 0:
 				return &v.state
@@ -1715,7 +4284,19 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_raffle_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EligibilityRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateProjectRequest); i {
 			case 0:
 				return &v.state
@@ -1727,8 +4308,224 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProjectResponse); i {
+		file_pb_raffle_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectDraw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectDrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectDrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserProjectDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserProjectDetailsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_raffle_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1739,8 +4536,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectRequest); i {
+		file_pb_raffle_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1751,8 +4548,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectResponse); i {
+		file_pb_raffle_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawVerification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1763,8 +4560,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllProjectResponse); i {
+		file_pb_raffle_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Allowlist); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1775,8 +4572,8 @@ func file_pb_raffle_proto_init() {
 				return nil
 			}
 		}
-		file_pb_raffle_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectRequest); i {
+		file_pb_raffle_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowlistProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1793,8 +4590,8 @@ func file_pb_raffle_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_raffle_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      3,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -1827,9 +4624,16 @@ type UserServiceClient interface {
 	GetUserProject(ctx context.Context, in *GetUserProjectRequest, opts ...grpc.CallOption) (*GetUserProjectResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	CreateUserWallet(ctx context.Context, in *CreateUserWalletRequest, opts ...grpc.CallOption) (*Empty, error)
+	UpdateUserWallet(ctx context.Context, in *UpdateUserWalletRequest, opts ...grpc.CallOption) (*UserWallet, error)
+	DeleteUserWallet(ctx context.Context, in *DeleteUserWalletRequest, opts ...grpc.CallOption) (*Empty, error)
+	// CreateUserProject fails with ALREADY_EXISTS if the user or the address has entered the project
 	CreateUserProject(ctx context.Context, in *CreateUserProjectRequest, opts ...grpc.CallOption) (*Empty, error)
+	// UpdateUserProject fails with ALREADY_EXISTS if the address has entered the project by another user
+	UpdateUserProject(ctx context.Context, in *UpdateUserProjectRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteUserProject(ctx context.Context, in *DeleteUserProjectRequest, opts ...grpc.CallOption) (*Empty, error)
 	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
 	LogoutUser(ctx context.Context, in *LogoutUserRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListProjectEntries(ctx context.Context, in *ListProjectEntriesRequest, opts ...grpc.CallOption) (*ListProjectEntriesResponse, error)
 }
 
 type userServiceClient struct {
@@ -1894,6 +4698,24 @@ func (c *userServiceClient) CreateUserWallet(ctx context.Context, in *CreateUser
 	return out, nil
 }
 
+func (c *userServiceClient) UpdateUserWallet(ctx context.Context, in *UpdateUserWalletRequest, opts ...grpc.CallOption) (*UserWallet, error) {
+	out := new(UserWallet)
+	err := c.cc.Invoke(ctx, "/pb.UserService/UpdateUserWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserWallet(ctx context.Context, in *DeleteUserWalletRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.UserService/DeleteUserWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CreateUserProject(ctx context.Context, in *CreateUserProjectRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/pb.UserService/CreateUserProject", in, out, opts...)
@@ -1903,6 +4725,24 @@ func (c *userServiceClient) CreateUserProject(ctx context.Context, in *CreateUse
 	return out, nil
 }
 
+func (c *userServiceClient) UpdateUserProject(ctx context.Context, in *UpdateUserProjectRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.UserService/UpdateUserProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserProject(ctx context.Context, in *DeleteUserProjectRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.UserService/DeleteUserProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error) {
 	out := new(LoginUserResponse)
 	err := c.cc.Invoke(ctx, "/pb.UserService/LoginUser", in, out, opts...)
@@ -1921,6 +4761,15 @@ func (c *userServiceClient) LogoutUser(ctx context.Context, in *LogoutUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) ListProjectEntries(ctx context.Context, in *ListProjectEntriesRequest, opts ...grpc.CallOption) (*ListProjectEntriesResponse, error) {
+	out := new(ListProjectEntriesResponse)
+	err := c.cc.Invoke(ctx, "/pb.UserService/ListProjectEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 type UserServiceServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*LoginUserResponse, error)
@@ -1929,9 +4778,16 @@ type UserServiceServer interface {
 	GetUserProject(context.Context, *GetUserProjectRequest) (*GetUserProjectResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*GetUserResponse, error)
 	CreateUserWallet(context.Context, *CreateUserWalletRequest) (*Empty, error)
+	UpdateUserWallet(context.Context, *UpdateUserWalletRequest) (*UserWallet, error)
+	DeleteUserWallet(context.Context, *DeleteUserWalletRequest) (*Empty, error)
+	// CreateUserProject fails with ALREADY_EXISTS if the user or the address has entered the project
 	CreateUserProject(context.Context, *CreateUserProjectRequest) (*Empty, error)
+	// UpdateUserProject fails with ALREADY_EXISTS if the address has entered the project by another user
+	UpdateUserProject(context.Context, *UpdateUserProjectRequest) (*Empty, error)
+	DeleteUserProject(context.Context, *DeleteUserProjectRequest) (*Empty, error)
 	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
 	LogoutUser(context.Context, *LogoutUserRequest) (*Empty, error)
+	ListProjectEntries(context.Context, *ListProjectEntriesRequest) (*ListProjectEntriesResponse, error)
 }
 
 // UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
@@ -1956,15 +4812,30 @@ func (*UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRe
 func (*UnimplementedUserServiceServer) CreateUserWallet(context.Context, *CreateUserWalletRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUserWallet not implemented")
 }
+func (*UnimplementedUserServiceServer) UpdateUserWallet(context.Context, *UpdateUserWalletRequest) (*UserWallet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserWallet not implemented")
+}
+func (*UnimplementedUserServiceServer) DeleteUserWallet(context.Context, *DeleteUserWalletRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserWallet not implemented")
+}
 func (*UnimplementedUserServiceServer) CreateUserProject(context.Context, *CreateUserProjectRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUserProject not implemented")
 }
+func (*UnimplementedUserServiceServer) UpdateUserProject(context.Context, *UpdateUserProjectRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserProject not implemented")
+}
+func (*UnimplementedUserServiceServer) DeleteUserProject(context.Context, *DeleteUserProjectRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserProject not implemented")
+}
 func (*UnimplementedUserServiceServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
 }
 func (*UnimplementedUserServiceServer) LogoutUser(context.Context, *LogoutUserRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LogoutUser not implemented")
 }
+func (*UnimplementedUserServiceServer) ListProjectEntries(context.Context, *ListProjectEntriesRequest) (*ListProjectEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectEntries not implemented")
+}
 
 func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
 	s.RegisterService(&_UserService_serviceDesc, srv)
@@ -2078,6 +4949,42 @@ func _UserService_CreateUserWallet_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdateUserWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.UserService/UpdateUserWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserWallet(ctx, req.(*UpdateUserWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.UserService/DeleteUserWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserWallet(ctx, req.(*DeleteUserWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CreateUserProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserProjectRequest)
 	if err := dec(in); err != nil {
@@ -2096,6 +5003,42 @@ func _UserService_CreateUserProject_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdateUserProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.UserService/UpdateUserProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserProject(ctx, req.(*UpdateUserProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.UserService/DeleteUserProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserProject(ctx, req.(*DeleteUserProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginUserRequest)
 	if err := dec(in); err != nil {
@@ -2132,6 +5075,24 @@ func _UserService_LogoutUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListProjectEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListProjectEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.UserService/ListProjectEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListProjectEntries(ctx, req.(*ListProjectEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.UserService",
 	HandlerType: (*UserServiceServer)(nil),
@@ -2160,10 +5121,26 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUserWallet",
 			Handler:    _UserService_CreateUserWallet_Handler,
 		},
+		{
+			MethodName: "UpdateUserWallet",
+			Handler:    _UserService_UpdateUserWallet_Handler,
+		},
+		{
+			MethodName: "DeleteUserWallet",
+			Handler:    _UserService_DeleteUserWallet_Handler,
+		},
 		{
 			MethodName: "CreateUserProject",
 			Handler:    _UserService_CreateUserProject_Handler,
 		},
+		{
+			MethodName: "UpdateUserProject",
+			Handler:    _UserService_UpdateUserProject_Handler,
+		},
+		{
+			MethodName: "DeleteUserProject",
+			Handler:    _UserService_DeleteUserProject_Handler,
+		},
 		{
 			MethodName: "LoginUser",
 			Handler:    _UserService_LoginUser_Handler,
@@ -2172,6 +5149,10 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LogoutUser",
 			Handler:    _UserService_LogoutUser_Handler,
 		},
+		{
+			MethodName: "ListProjectEntries",
+			Handler:    _UserService_ListProjectEntries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb/raffle.proto",
@@ -2184,7 +5165,13 @@ type ProjectServiceClient interface {
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
 	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
 	GetAllProjects(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetAllProjectResponse, error)
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
+	CreateProjectDraw(ctx context.Context, in *CreateProjectDrawRequest, opts ...grpc.CallOption) (*ProjectDraw, error)
+	GetProjectDraw(ctx context.Context, in *GetProjectDrawRequest, opts ...grpc.CallOption) (*ProjectDraw, error)
+	ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error)
+	SetProjectMember(ctx context.Context, in *ProjectMember, opts ...grpc.CallOption) (*ProjectMember, error)
+	DeleteProjectMember(ctx context.Context, in *DeleteProjectMemberRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type projectServiceClient struct {
@@ -2222,6 +5209,15 @@ func (c *projectServiceClient) GetAllProjects(ctx context.Context, in *Empty, op
 	return out, nil
 }
 
+func (c *projectServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+	out := new(ListProjectsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/ListProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
 	out := new(GetProjectResponse)
 	err := c.cc.Invoke(ctx, "/pb.ProjectService/UpdateProject", in, out, opts...)
@@ -2231,12 +5227,63 @@ func (c *projectServiceClient) UpdateProject(ctx context.Context, in *UpdateProj
 	return out, nil
 }
 
+func (c *projectServiceClient) CreateProjectDraw(ctx context.Context, in *CreateProjectDrawRequest, opts ...grpc.CallOption) (*ProjectDraw, error) {
+	out := new(ProjectDraw)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/CreateProjectDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetProjectDraw(ctx context.Context, in *GetProjectDrawRequest, opts ...grpc.CallOption) (*ProjectDraw, error) {
+	out := new(ProjectDraw)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/GetProjectDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error) {
+	out := new(ListProjectMembersResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/ListProjectMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) SetProjectMember(ctx context.Context, in *ProjectMember, opts ...grpc.CallOption) (*ProjectMember, error) {
+	out := new(ProjectMember)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/SetProjectMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteProjectMember(ctx context.Context, in *DeleteProjectMemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/DeleteProjectMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 type ProjectServiceServer interface {
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
 	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
 	GetAllProjects(context.Context, *Empty) (*GetAllProjectResponse, error)
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	UpdateProject(context.Context, *UpdateProjectRequest) (*GetProjectResponse, error)
+	CreateProjectDraw(context.Context, *CreateProjectDrawRequest) (*ProjectDraw, error)
+	GetProjectDraw(context.Context, *GetProjectDrawRequest) (*ProjectDraw, error)
+	ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error)
+	SetProjectMember(context.Context, *ProjectMember) (*ProjectMember, error)
+	DeleteProjectMember(context.Context, *DeleteProjectMemberRequest) (*Empty, error)
 }
 
 // UnimplementedProjectServiceServer can be embedded to have forward compatible implementations.
@@ -2252,9 +5299,27 @@ func (*UnimplementedProjectServiceServer) GetProject(context.Context, *GetProjec
 func (*UnimplementedProjectServiceServer) GetAllProjects(context.Context, *Empty) (*GetAllProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllProjects not implemented")
 }
+func (*UnimplementedProjectServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
 func (*UnimplementedProjectServiceServer) UpdateProject(context.Context, *UpdateProjectRequest) (*GetProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
 }
+func (*UnimplementedProjectServiceServer) CreateProjectDraw(context.Context, *CreateProjectDrawRequest) (*ProjectDraw, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProjectDraw not implemented")
+}
+func (*UnimplementedProjectServiceServer) GetProjectDraw(context.Context, *GetProjectDrawRequest) (*ProjectDraw, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectDraw not implemented")
+}
+func (*UnimplementedProjectServiceServer) ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMembers not implemented")
+}
+func (*UnimplementedProjectServiceServer) SetProjectMember(context.Context, *ProjectMember) (*ProjectMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectMember not implemented")
+}
+func (*UnimplementedProjectServiceServer) DeleteProjectMember(context.Context, *DeleteProjectMemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectMember not implemented")
+}
 
 func RegisterProjectServiceServer(s *grpc.Server, srv ProjectServiceServer) {
 	s.RegisterService(&_ProjectService_serviceDesc, srv)
@@ -2314,6 +5379,24 @@ func _ProjectService_GetAllProjects_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/ListProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProjectRequest)
 	if err := dec(in); err != nil {
@@ -2332,6 +5415,96 @@ func _ProjectService_UpdateProject_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_CreateProjectDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateProjectDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/CreateProjectDraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateProjectDraw(ctx, req.(*CreateProjectDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetProjectDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/GetProjectDraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectDraw(ctx, req.(*GetProjectDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListProjectMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListProjectMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/ListProjectMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListProjectMembers(ctx, req.(*ListProjectMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_SetProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).SetProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/SetProjectMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).SetProjectMember(ctx, req.(*ProjectMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/DeleteProjectMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteProjectMember(ctx, req.(*DeleteProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProjectService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.ProjectService",
 	HandlerType: (*ProjectServiceServer)(nil),
@@ -2348,10 +5521,34 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllProjects",
 			Handler:    _ProjectService_GetAllProjects_Handler,
 		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _ProjectService_ListProjects_Handler,
+		},
 		{
 			MethodName: "UpdateProject",
 			Handler:    _ProjectService_UpdateProject_Handler,
 		},
+		{
+			MethodName: "CreateProjectDraw",
+			Handler:    _ProjectService_CreateProjectDraw_Handler,
+		},
+		{
+			MethodName: "GetProjectDraw",
+			Handler:    _ProjectService_GetProjectDraw_Handler,
+		},
+		{
+			MethodName: "ListProjectMembers",
+			Handler:    _ProjectService_ListProjectMembers_Handler,
+		},
+		{
+			MethodName: "SetProjectMember",
+			Handler:    _ProjectService_SetProjectMember_Handler,
+		},
+		{
+			MethodName: "DeleteProjectMember",
+			Handler:    _ProjectService_DeleteProjectMember_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb/raffle.proto",
@@ -0,0 +1,158 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package logging
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Levels holds the default log level and the levels overridden per logger name.
+// A level set for a name also applies to its descendants (e.g., "front-service" to "front-service.access"),
+// unless they have their own level
+type Levels struct {
+	lock sync.RWMutex
+
+	defaultLevel zapcore.Level
+	named        map[string]zapcore.Level
+}
+
+// NewLevels is a constructor for the Levels
+func NewLevels(defaultLevel zapcore.Level) *Levels {
+	return &Levels{
+		defaultLevel: defaultLevel,
+		named:        map[string]zapcore.Level{},
+	}
+}
+
+// ParseLevel parses a level name, one of debug, info, warn and error
+func ParseLevel(s string) (zapcore.Level, error) {
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(strings.ToLower(s))); err != nil {
+		return lvl, err
+	}
+	if lvl < zapcore.DebugLevel || lvl > zapcore.ErrorLevel {
+		return lvl, fmt.Errorf("unsupported log level %q", s)
+	}
+	return lvl, nil
+}
+
+// ParseNamedLevels parses comma-separated name=level pairs (e.g., "front-service=debug,logrotate=error")
+func (l *Levels) ParseNamedLevels(spec string) error {
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return fmt.Errorf("invalid named log level %q", pair)
+		}
+		lvl, err := ParseLevel(kv[1])
+		if err != nil {
+			return err
+		}
+		l.SetLevel(kv[0], lvl)
+	}
+	return nil
+}
+
+// SetLevel sets the level of the named logger. An empty name sets the default level
+func (l *Levels) SetLevel(name string, lvl zapcore.Level) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if name == "" {
+		l.defaultLevel = lvl
+		return
+	}
+	l.named[name] = lvl
+}
+
+// ResetLevel removes the level overridden for the named logger
+func (l *Levels) ResetLevel(name string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.named, name)
+}
+
+// Level returns the effective level of the named logger
+func (l *Levels) Level(name string) zapcore.Level {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	for {
+		if lvl, ok := l.named[name]; ok {
+			return lvl
+		}
+		i := strings.LastIndex(name, ".")
+		if i < 0 {
+			return l.defaultLevel
+		}
+		name = name[:i]
+	}
+}
+
+// Snapshot returns the default level and a copy of the named levels
+func (l *Levels) Snapshot() (zapcore.Level, map[string]zapcore.Level) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	named := make(map[string]zapcore.Level, len(l.named))
+	for k, v := range l.named {
+		named[k] = v
+	}
+	return l.defaultLevel, named
+}
+
+// Enabled implements zapcore.LevelEnabler. It is true if any of the loggers is enabled at lvl
+func (l *Levels) Enabled(lvl zapcore.Level) bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	if lvl >= l.defaultLevel {
+		return true
+	}
+	for _, named := range l.named {
+		if lvl >= named {
+			return true
+		}
+	}
+	return false
+}
+
+// WrapCore wraps a core to filter entries by the level of their logger names
+func (l *Levels) WrapCore(core zapcore.Core) zapcore.Core {
+	return &levelCore{Core: core, levels: l}
+}
+
+type levelCore struct {
+	zapcore.Core
+	levels *Levels
+}
+
+// With adds fields to the core, keeping it wrapped
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), levels: c.levels}
+}
+
+// Check drops the entry if its logger is not enabled at its level
+func (c *levelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if ent.Level < c.levels.Level(ent.LoggerName) {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
@@ -0,0 +1,52 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package logging
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-logr/logr"
+	uberzap "go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+// Log encoders
+const (
+	JSONEncoder    = "json"
+	ConsoleEncoder = "console"
+)
+
+// New builds a zap-backed logger writing to out with the encoder, whose levels are controlled by levels
+func New(out io.Writer, encoder string, levels *Levels) (logr.Logger, error) {
+	var encoderOpt zap.Opts
+	switch encoder {
+	case JSONEncoder:
+		encoderOpt = zap.JSONEncoder()
+	case ConsoleEncoder:
+		encoderOpt = zap.ConsoleEncoder()
+	default:
+		return logr.Logger{}, fmt.Errorf("unknown log encoder %q", encoder)
+	}
+
+	return zap.New(
+		encoderOpt,
+		zap.Level(levels),
+		zap.WriteTo(out),
+		zap.RawZapOpts(uberzap.WrapCore(levels.WrapCore)),
+	), nil
+}
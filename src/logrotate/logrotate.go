@@ -17,12 +17,19 @@
 package logrotate
 
 import (
+	"compress/gzip"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"os/signal"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"gopkg.in/robfig/cron.v2"
@@ -32,25 +39,365 @@ import (
 const (
 	defaultLogFileDir = "/logs"
 	logFilePrefix     = "frontservice"
+
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+	backupCounterSep = "_"
+	logFileExt       = ".log"
+	compressExt      = ".gz"
 )
 
 var logDir = defaultLogFileDir
-var logFilePath = path.Join(logDir, fmt.Sprintf("%s.log", logFilePrefix))
+var logFilePath = path.Join(logDir, fmt.Sprintf("%s%s", logFilePrefix, logFileExt))
 var logger = ctrl.Log.WithName("logrotate")
-var logFile *os.File
 
-// LogFile opens a file for the log
-func LogFile() (*os.File, error) {
+// logFile is the writer opened by LogFile, rotated by the cronjob and reopened by signals
+var logFile *Writer
+var logFileLock sync.RWMutex
+
+// Options configures the rotation of the log file
+type Options struct {
+	// MaxSize is the size in bytes which triggers a rotation. Zero disables size-based rotation
+	MaxSize int64
+	// MaxAge is the maximum age of the backups to retain. Zero retains backups regardless of their age
+	MaxAge time.Duration
+	// MaxBackups is the maximum number of backups to retain. Zero retains all the backups
+	MaxBackups int
+	// Compress compresses the backups with gzip
+	Compress bool
+}
+
+// OptionsFromEnv builds Options from LOG_MAX_SIZE_MB, LOG_MAX_AGE_DAYS, LOG_MAX_BACKUPS and LOG_COMPRESS
+func OptionsFromEnv() (Options, error) {
+	opts := Options{}
+	if v := os.Getenv("LOG_MAX_SIZE_MB"); v != "" {
+		size, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || size < 0 {
+			return opts, fmt.Errorf("invalid LOG_MAX_SIZE_MB %q", v)
+		}
+		opts.MaxSize = size * 1024 * 1024
+	}
+	if v := os.Getenv("LOG_MAX_AGE_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days < 0 {
+			return opts, fmt.Errorf("invalid LOG_MAX_AGE_DAYS %q", v)
+		}
+		opts.MaxAge = time.Duration(days) * 24 * time.Hour
+	}
+	if v := os.Getenv("LOG_MAX_BACKUPS"); v != "" {
+		backups, err := strconv.Atoi(v)
+		if err != nil || backups < 0 {
+			return opts, fmt.Errorf("invalid LOG_MAX_BACKUPS %q", v)
+		}
+		opts.MaxBackups = backups
+	}
+	if v := os.Getenv("LOG_COMPRESS"); v != "" {
+		compress, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("invalid LOG_COMPRESS %q", v)
+		}
+		opts.Compress = compress
+	}
+	return opts, nil
+}
+
+// Writer is a log file writer which is rotated by renaming the file to a backup and opening a new one.
+// Writes are serialized with rotations, so that no line is lost while rotating
+type Writer struct {
+	lock sync.Mutex
+
+	opts Options
+	file *os.File
+	size int64
+
+	millCh chan struct{}
+}
+
+// LogFile opens a file for the log. Logs of the previous runs are kept, as the file is opened in append mode
+func LogFile(opts Options) (*Writer, error) {
 	dir := filepath.Dir(logFilePath)
 	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
 		return nil, err
 	}
-	file, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, os.FileMode(0644))
+	file, size, err := openLogFile()
 	if err != nil {
 		return nil, err
 	}
-	logFile = file
-	return file, nil
+	w := &Writer{opts: opts, file: file, size: size, millCh: make(chan struct{}, 1)}
+	go w.millRun()
+
+	logFileLock.Lock()
+	logFile = w
+	logFileLock.Unlock()
+	return w, nil
+}
+
+func currentLogFile() *Writer {
+	logFileLock.RLock()
+	defer logFileLock.RUnlock()
+	return logFile
+}
+
+// openLogFile opens the log file in append mode and returns it with its current size
+func openLogFile() (*os.File, int64, error) {
+	file, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, os.FileMode(0644))
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, 0, err
+	}
+	return file, info.Size(), nil
+}
+
+// Write writes p to the log file, rotating it first if p exceeds the max size
+func (w *Writer) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.file == nil {
+		return 0, fmt.Errorf("log file is closed")
+	}
+
+	if w.opts.MaxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.opts.MaxSize {
+		// Keep writing to the current file even if the rotation fails
+		_ = w.rotate()
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// Rotate rotates the log file
+func (w *Writer) Rotate() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.rotate()
+}
+
+// Reopen closes and reopens the log file, so that the writes go to a new file
+// after the file is moved by an external tool (e.g., logrotate)
+func (w *Writer) Reopen() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.file == nil {
+		return fmt.Errorf("log file is closed")
+	}
+	file, size, err := openLogFile()
+	if err != nil {
+		return err
+	}
+	_ = w.file.Close()
+	w.file = file
+	w.size = size
+	return nil
+}
+
+// Close closes the log file
+func (w *Writer) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// rotate renames the current file to a backup and opens a new one. w.lock must be held
+func (w *Writer) rotate() error {
+	if w.file == nil {
+		return fmt.Errorf("log file is closed")
+	}
+	backupPath, err := newBackupPath(time.Now())
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(logFilePath, backupPath); err != nil {
+		return err
+	}
+	file, _, err := openLogFile()
+	if err != nil {
+		// The old file is still open (as the backup), so that the logs are written there until the next rotation
+		return err
+	}
+	_ = w.file.Close()
+	w.file = file
+	w.size = 0
+
+	// Compress and clean up the backups in background
+	select {
+	case w.millCh <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// newBackupPath returns a backup path which does not exist, either compressed or not. Rotations in the same
+// millisecond are told apart by a counter after the timestamp, for os.Rename not to overwrite the previous backup
+func newBackupPath(now time.Time) (string, error) {
+	ts := now.Format(backupTimeFormat)
+	for n := 0; ; n++ {
+		name := ts
+		if n > 0 {
+			name = fmt.Sprintf("%s%s%d", ts, backupCounterSep, n)
+		}
+		backupPath := path.Join(logDir, fmt.Sprintf("%s.%s%s", logFilePrefix, name, logFileExt))
+		exists, err := fileExists(backupPath)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			if exists, err = fileExists(backupPath + compressExt); err != nil {
+				return "", err
+			}
+		}
+		if !exists {
+			return backupPath, nil
+		}
+	}
+}
+
+func fileExists(filePath string) (bool, error) {
+	_, err := os.Lstat(filePath)
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// millRun compresses and removes the backups. Logs of the logrotate are written here, not to deadlock with Write
+func (w *Writer) millRun() {
+	for range w.millCh {
+		logger.Info("Log rotated")
+		if err := w.mill(); err != nil {
+			logger.Error(err, "log backup cleanup error")
+		}
+	}
+}
+
+func (w *Writer) mill() error {
+	backups, err := listBackups()
+	if err != nil {
+		return err
+	}
+
+	var remove []backup
+	var keep []backup
+	cutoff := time.Now().Add(-w.opts.MaxAge)
+	for _, b := range backups {
+		if (w.opts.MaxBackups > 0 && len(keep) >= w.opts.MaxBackups) || (w.opts.MaxAge > 0 && b.timestamp.Before(cutoff)) {
+			remove = append(remove, b)
+			continue
+		}
+		keep = append(keep, b)
+	}
+
+	for _, b := range remove {
+		if err := os.Remove(b.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if !w.opts.Compress {
+		return nil
+	}
+	for _, b := range keep {
+		if strings.HasSuffix(b.path, compressExt) {
+			continue
+		}
+		if err := compress(b.path); err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Log backup compressed (%s%s)", b.path, compressExt))
+	}
+	return nil
+}
+
+type backup struct {
+	path      string
+	timestamp time.Time
+	// counter tells apart the backups rotated in the same millisecond
+	counter int
+}
+
+// listBackups lists the backups, newest first
+func listBackups() ([]backup, error) {
+	files, err := ioutil.ReadDir(logDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []backup
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		name := strings.TrimSuffix(f.Name(), compressExt)
+		if !strings.HasPrefix(name, logFilePrefix+".") || !strings.HasSuffix(name, logFileExt) {
+			continue
+		}
+		name = strings.TrimSuffix(strings.TrimPrefix(name, logFilePrefix+"."), logFileExt)
+		counter := 0
+		if i := strings.Index(name, backupCounterSep); i >= 0 {
+			n, err := strconv.Atoi(name[i+len(backupCounterSep):])
+			if err != nil || n <= 0 {
+				continue
+			}
+			name, counter = name[:i], n
+		}
+		ts, err := time.ParseInLocation(backupTimeFormat, name, time.Local)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, backup{path: path.Join(logDir, f.Name()), timestamp: ts, counter: counter})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		if backups[i].timestamp.Equal(backups[j].timestamp) {
+			return backups[i].counter > backups[j].counter
+		}
+		return backups[i].timestamp.After(backups[j].timestamp)
+	})
+	return backups, nil
+}
+
+// compress gzips the file and removes the original one
+func compress(filePath string) error {
+	in, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+
+	gzPath := filePath + compressExt
+	out, err := os.OpenFile(gzPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(0644))
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(out)
+	if _, err := io.Copy(gz, in); err != nil {
+		_ = out.Close()
+		_ = os.Remove(gzPath)
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		_ = out.Close()
+		_ = os.Remove(gzPath)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(gzPath)
+		return err
+	}
+	return os.Remove(filePath)
 }
 
 // StartRotate starts a cronjob to rotate the log
@@ -64,28 +411,30 @@ func StartRotate(spec string) error {
 }
 
 func rotateLog() {
-	in, err := ioutil.ReadFile(logFilePath)
-	if err != nil {
-		logger.Error(err, "read log error")
-		return
-	}
-
-	filePath := path.Join(logDir, fmt.Sprintf("%s.%s.log", logFilePrefix, time.Now().AddDate(0, 0, -1).Format("2006-01-02")))
-	if err := ioutil.WriteFile(filePath, in, 0644); err != nil {
-		logger.Error(err, "write log error")
+	w := currentLogFile()
+	if w == nil {
 		return
 	}
-
-	logger.Info(fmt.Sprintf("Log backup succeeded (%s)", filePath))
-	if err := os.Truncate(logFilePath, 0); err != nil {
-		logger.Error(err, "truncate log error")
-		return
+	if err := w.Rotate(); err != nil {
+		logger.Error(err, "rotate log error")
 	}
+}
 
-	if logFile != nil {
-		if _, err := logFile.Seek(0, io.SeekStart); err != nil {
-			logger.Error(err, "seek log error")
-			return
+// ReopenOnSignal reopens the log file whenever SIGHUP is received
+func ReopenOnSignal() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	go func() {
+		for range ch {
+			w := currentLogFile()
+			if w == nil {
+				continue
+			}
+			if err := w.Reopen(); err != nil {
+				logger.Error(err, "reopen log error")
+				continue
+			}
+			logger.Info("Log file reopened")
 		}
-	}
+	}()
 }
@@ -19,12 +19,14 @@ package main
 import (
 	"context"
 	"fmt"
+	"github.com/theraffle/frontservice/src/logging"
 	"github.com/theraffle/frontservice/src/logrotate"
 	"github.com/theraffle/frontservice/src/server"
+	"github.com/theraffle/frontservice/src/utils"
 	"io"
 	"os"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"strconv"
 )
 
 var (
@@ -32,36 +34,85 @@ var (
 )
 
 const (
-	port = "8080"
+	port       = "8080"
+	logEncoder = logging.JSONEncoder
+	logLevel   = "info"
 )
 
 func main() {
 	ctx := context.Background()
-	// Set log rotation
-	logFile, err := logrotate.LogFile()
+	levels, closeLog, err := setLogger()
 	if err != nil {
 		fmt.Println(err.Error())
 		os.Exit(1)
 	}
-	defer func() {
-		_ = logFile.Close()
-	}()
-	logWriter := io.MultiWriter(logFile, os.Stdout)
-	ctrl.SetLogger(zap.New(zap.UseDevMode(true), zap.WriteTo(logWriter)))
-	if err := logrotate.StartRotate("0 0 1 * * ?"); err != nil {
-		setupLog.Error(err, "")
-		os.Exit(1)
-	}
+	defer closeLog()
 
 	// set port
 	srvPort := port
 	if os.Getenv("PORT") != "" {
 		srvPort = os.Getenv("PORT")
 	}
-	srv, err := server.New(ctx)
+	srv, err := server.New(ctx, levels)
 	if err != nil {
 		setupLog.Error(err, "")
 		os.Exit(1)
 	}
 	srv.Start(srvPort)
 }
+
+// setLogger sets the logger writing to stdout and to the rotated log file.
+// File logging can be disabled (LOG_FILE=false) to log only to stdout
+func setLogger() (*logging.Levels, func(), error) {
+	logToFileEnv := "true"
+	utils.MapEnv(&logToFileEnv, "LOG_FILE")
+	logToFile, err := strconv.ParseBool(logToFileEnv)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid LOG_FILE %q", logToFileEnv)
+	}
+
+	// Set log rotation
+	var logWriter io.Writer = os.Stdout
+	closeLog := func() {}
+	if logToFile {
+		rotateOpts, err := logrotate.OptionsFromEnv()
+		if err != nil {
+			return nil, nil, err
+		}
+		logFile, err := logrotate.LogFile(rotateOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		closeLog = func() {
+			_ = logFile.Close()
+		}
+		logWriter = io.MultiWriter(logFile, os.Stdout)
+	}
+
+	// Set logger
+	encoder, level, namedLevels := logEncoder, logLevel, ""
+	utils.MapEnv(&encoder, "LOG_ENCODER")
+	utils.MapEnv(&level, "LOG_LEVEL")
+	utils.MapEnv(&namedLevels, "LOG_LEVELS")
+	defaultLevel, err := logging.ParseLevel(level)
+	if err != nil {
+		return nil, nil, err
+	}
+	levels := logging.NewLevels(defaultLevel)
+	if err := levels.ParseNamedLevels(namedLevels); err != nil {
+		return nil, nil, err
+	}
+	logger, err := logging.New(logWriter, encoder, levels)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctrl.SetLogger(logger)
+
+	if logToFile {
+		if err := logrotate.StartRotate("0 0 1 * * ?"); err != nil {
+			return nil, nil, err
+		}
+		logrotate.ReopenOnSignal()
+	}
+	return levels, closeLog, nil
+}
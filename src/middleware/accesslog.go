@@ -0,0 +1,143 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/auth"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// AccessLogFormat is a format of the access log line
+type AccessLogFormat string
+
+// Access log formats
+const (
+	AccessLogJSON     = AccessLogFormat("json")
+	AccessLogCombined = AccessLogFormat("combined")
+)
+
+const (
+	combinedTimeFormat = "02/Jan/2006:15:04:05 -0700"
+)
+
+// ParseAccessLogFormat parses the access log format string
+func ParseAccessLogFormat(s string) (AccessLogFormat, error) {
+	switch f := AccessLogFormat(s); f {
+	case AccessLogJSON, AccessLogCombined:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown access log format %q", s)
+	}
+}
+
+// AccessLog logs a line for each request, after it is served.
+// Route templates are resolved by matching the request against router
+func AccessLog(log logr.Logger, format AccessLogFormat, router *mux.Router, proxies utils.TrustedProxies) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			start := time.Now()
+			rec := newResponseRecorder(w)
+			next.ServeHTTP(rec, req)
+
+			entry := &accessEntry{
+				start:    start,
+				duration: time.Since(start),
+				route:    routeTemplate(router, req),
+				status:   rec.Status(),
+				bytes:    rec.bytes,
+				remote:   proxies.ClientIP(req),
+			}
+			if id, ok := auth.UserID(req.Context()); ok {
+				entry.userID = strconv.FormatInt(id, 10)
+			}
+
+			if format == AccessLogCombined {
+				log.Info(entry.combined(req), "request_id", utils.RequestID(req), "duration", entry.duration.String())
+				return
+			}
+			log.Info("access", entry.keysAndValues(req)...)
+		})
+	}
+}
+
+type accessEntry struct {
+	start    time.Time
+	duration time.Duration
+	route    string
+	status   int
+	bytes    int
+	remote   string
+	userID   string
+}
+
+func (e *accessEntry) keysAndValues(req *http.Request) []interface{} {
+	kv := []interface{}{
+		"method", req.Method,
+		"route", e.route,
+		"path", req.URL.Path,
+		"status", e.status,
+		"duration_ms", float64(e.duration) / float64(time.Millisecond),
+		"bytes", e.bytes,
+		"remote_addr", e.remote,
+		"request_id", utils.RequestID(req),
+	}
+	if e.userID != "" {
+		kv = append(kv, "user_id", e.userID)
+	}
+	return kv
+}
+
+// combined builds a line of Apache combined log format
+func (e *accessEntry) combined(req *http.Request) string {
+	return fmt.Sprintf("%s - %s [%s] \"%s %s %s\" %d %s \"%s\" \"%s\"",
+		e.remote, dashIfEmpty(e.userID), e.start.Format(combinedTimeFormat),
+		req.Method, req.URL.RequestURI(), req.Proto, e.status, dashIfZero(e.bytes),
+		dashIfEmpty(req.Referer()), dashIfEmpty(req.UserAgent()))
+}
+
+func routeTemplate(router *mux.Router, req *http.Request) string {
+	var match mux.RouteMatch
+	if router == nil || !router.Match(req, &match) || match.Route == nil {
+		return ""
+	}
+	tpl, err := match.Route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+	return tpl
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func dashIfZero(n int) string {
+	if n == 0 {
+		return "-"
+	}
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,241 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package middleware
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// Encoder creates a compressing writer of a content coding
+type Encoder func(w io.Writer) io.WriteCloser
+
+// CompressionOptions configures the response compression
+type CompressionOptions struct {
+	// MinSize is the minimum size of the response body to be compressed
+	MinSize int
+	// Encoders are the supported content codings (e.g., gzip), in the order of the server preference.
+	// Other codings (e.g., br, zstd) can be plugged in
+	Encoders []NamedEncoder
+	// ContentTypes are the compressible media types
+	ContentTypes []string
+}
+
+// NamedEncoder is an Encoder with its content coding name
+type NamedEncoder struct {
+	Name    string
+	Encoder Encoder
+}
+
+// GzipEncoder is an Encoder of gzip
+func GzipEncoder(w io.Writer) io.WriteCloser {
+	return gzip.NewWriter(w)
+}
+
+// DefaultCompressionOptions returns the default compression options, compressing json responses over 1KiB with gzip
+func DefaultCompressionOptions() CompressionOptions {
+	return CompressionOptions{
+		MinSize:      1 << 10,
+		Encoders:     []NamedEncoder{{Name: "gzip", Encoder: GzipEncoder}},
+		ContentTypes: []string{utils.ContentTypeJSON, utils.ContentTypeProtobuf, "text/plain", "text/csv"},
+	}
+}
+
+// CompressionOptionsFromEnv overrides the default compression options by COMPRESSION_MIN_SIZE
+func CompressionOptionsFromEnv() (CompressionOptions, error) {
+	opts := DefaultCompressionOptions()
+	if v := os.Getenv("COMPRESSION_MIN_SIZE"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size < 0 {
+			return opts, fmt.Errorf("invalid COMPRESSION_MIN_SIZE %q", v)
+		}
+		opts.MinSize = size
+	}
+	return opts, nil
+}
+
+// Compress compresses the response bodies with the content coding negotiated by Accept-Encoding.
+// Bodies smaller than MinSize are sent as they are
+func Compress(opts CompressionOptions) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Add("Vary", "Accept-Encoding")
+			encoder := opts.negotiate(req.Header.Get("Accept-Encoding"))
+			if encoder == nil || req.Method == http.MethodHead {
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			cw := &compressWriter{ResponseWriter: w, opts: opts, encoder: encoder}
+			defer cw.close()
+			next.ServeHTTP(cw, req)
+		})
+	}
+}
+
+// negotiate chooses the encoder with the highest quality value. Ties are broken by the server preference
+func (o CompressionOptions) negotiate(acceptEncoding string) *NamedEncoder {
+	if acceptEncoding == "" {
+		return nil
+	}
+	qualities := map[string]float64{}
+	for _, item := range strings.Split(acceptEncoding, ",") {
+		params := strings.Split(item, ";")
+		name := strings.ToLower(strings.TrimSpace(params[0]))
+		q := 1.0
+		for _, p := range params[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				if v, err := strconv.ParseFloat(p[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		qualities[name] = q
+	}
+
+	var candidates []int
+	for i, e := range o.Encoders {
+		q, ok := qualities[e.Name]
+		if !ok {
+			q, ok = qualities[anyMatch]
+		}
+		if ok && q > 0 {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return quality(qualities, o.Encoders[candidates[i]].Name) > quality(qualities, o.Encoders[candidates[j]].Name)
+	})
+	return &o.Encoders[candidates[0]]
+}
+
+func quality(qualities map[string]float64, name string) float64 {
+	if q, ok := qualities[name]; ok {
+		return q
+	}
+	return qualities[anyMatch]
+}
+
+// compressWriter buffers the body until MinSize, then decides whether to compress it
+type compressWriter struct {
+	http.ResponseWriter
+
+	opts    CompressionOptions
+	encoder *NamedEncoder
+
+	status  int
+	buf     []byte
+	decided bool
+	writer  io.WriteCloser
+}
+
+// WriteHeader defers writing the header until the body is decided to be compressed or not
+func (c *compressWriter) WriteHeader(code int) {
+	if c.status == 0 {
+		c.status = code
+	}
+}
+
+// Write buffers the body until MinSize
+func (c *compressWriter) Write(b []byte) (int, error) {
+	if c.status == 0 {
+		c.status = http.StatusOK
+	}
+	if c.decided {
+		return c.write(b)
+	}
+	c.buf = append(c.buf, b...)
+	if len(c.buf) < c.opts.MinSize {
+		return len(b), nil
+	}
+	c.decide(true)
+	if _, err := c.write(c.buf); err != nil {
+		return 0, err
+	}
+	c.buf = nil
+	return len(b), nil
+}
+
+// Flush writes the buffered body and flushes the underlying writer
+func (c *compressWriter) Flush() {
+	if !c.decided {
+		c.decide(len(c.buf) >= c.opts.MinSize)
+		_, _ = c.write(c.buf)
+		c.buf = nil
+	}
+	if f, ok := c.writer.(interface{ Flush() error }); ok {
+		_ = f.Flush()
+	}
+	if f, ok := c.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (c *compressWriter) write(b []byte) (int, error) {
+	if c.writer != nil {
+		return c.writer.Write(b)
+	}
+	return c.ResponseWriter.Write(b)
+}
+
+// decide writes the header, with Content-Encoding if the body is compressed
+func (c *compressWriter) decide(large bool) {
+	c.decided = true
+	if c.status == 0 {
+		c.status = http.StatusOK
+	}
+	h := c.ResponseWriter.Header()
+	if large && h.Get("Content-Encoding") == "" && c.compressible(h.Get("Content-Type")) &&
+		c.status != http.StatusNoContent && c.status != http.StatusNotModified {
+		h.Set("Content-Encoding", c.encoder.Name)
+		h.Del("Content-Length")
+		c.writer = c.encoder.Encoder(c.ResponseWriter)
+	}
+	c.ResponseWriter.WriteHeader(c.status)
+}
+
+func (c *compressWriter) compressible(contentType string) bool {
+	mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+	return containsFold(c.opts.ContentTypes, mediaType)
+}
+
+// close writes the remaining body and finishes the compression
+func (c *compressWriter) close() {
+	if !c.decided {
+		if c.status == 0 && len(c.buf) == 0 {
+			return
+		}
+		c.decide(false)
+		_, _ = c.write(c.buf)
+	}
+	if c.writer != nil {
+		_ = c.writer.Close()
+	}
+}
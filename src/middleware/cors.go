@@ -0,0 +1,216 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// CORSOptions is a CORS policy
+type CORSOptions struct {
+	// AllowedOrigins are exact origins (e.g., "https://theraffle.me"), wildcard subdomains (e.g., "https://*.theraffle.me")
+	// or "*" for any origin
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposedHeaders []string
+
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// DefaultCORSOptions returns the default CORS policy, allowing the web app of The Raffle
+func DefaultCORSOptions() CORSOptions {
+	return CORSOptions{
+		AllowedOrigins: []string{"https://theraffle.me", "https://*.theraffle.me"},
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+		AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "If-Match", "If-None-Match", requestIDHeader},
+		ExposedHeaders: []string{"ETag", requestIDHeader, "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"},
+		MaxAge:         10 * time.Minute,
+	}
+}
+
+// CORSOptionsFromEnv overrides the default CORS policy by CORS_ALLOWED_ORIGINS, CORS_ALLOWED_METHODS,
+// CORS_ALLOWED_HEADERS, CORS_ALLOW_CREDENTIALS and CORS_MAX_AGE
+func CORSOptionsFromEnv() (CORSOptions, error) {
+	opts := DefaultCORSOptions()
+	if v, ok := os.LookupEnv("CORS_ALLOWED_ORIGINS"); ok {
+		opts.AllowedOrigins = utils.SplitList(v)
+	}
+	if v := os.Getenv("CORS_ALLOWED_METHODS"); v != "" {
+		opts.AllowedMethods = utils.SplitList(strings.ToUpper(v))
+	}
+	if v := os.Getenv("CORS_ALLOWED_HEADERS"); v != "" {
+		opts.AllowedHeaders = utils.SplitList(v)
+	}
+	if v := os.Getenv("CORS_ALLOW_CREDENTIALS"); v != "" {
+		credentials, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("invalid CORS_ALLOW_CREDENTIALS %q", v)
+		}
+		opts.AllowCredentials = credentials
+	}
+	if v := os.Getenv("CORS_MAX_AGE"); v != "" {
+		maxAge, err := time.ParseDuration(v)
+		if err != nil {
+			return opts, fmt.Errorf("invalid CORS_MAX_AGE %q", v)
+		}
+		opts.MaxAge = maxAge
+	}
+	return opts, opts.Validate()
+}
+
+// Validate checks if the origins are well-formed. Any origin cannot be allowed with credentials,
+// as it would let any site make credentialed requests
+func (o CORSOptions) Validate() error {
+	for _, origin := range o.AllowedOrigins {
+		if origin == anyMatch {
+			if o.AllowCredentials {
+				return fmt.Errorf("allowed origin %q cannot be used with credentials", anyMatch)
+			}
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || u.Path != "" {
+			return fmt.Errorf("invalid allowed origin %q", origin)
+		}
+	}
+	return nil
+}
+
+// CORS applies the CORS policy. Preflight requests are answered for every route of router,
+// with the allowed methods which are registered for the path
+func CORS(opts CORSOptions, router *mux.Router) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			origin := req.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(w, req)
+				return
+			}
+			w.Header().Add("Vary", "Origin")
+
+			preflight := req.Method == http.MethodOptions && req.Header.Get("Access-Control-Request-Method") != ""
+			if !opts.originAllowed(origin) {
+				if preflight {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			if !preflight {
+				opts.setAllowOrigin(w, origin)
+				if len(opts.ExposedHeaders) > 0 {
+					w.Header().Set("Access-Control-Expose-Headers", strings.Join(opts.ExposedHeaders, ", "))
+				}
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			methods := opts.routeMethods(router, req)
+			if len(methods) == 0 {
+				next.ServeHTTP(w, req)
+				return
+			}
+			if !containsFold(methods, req.Header.Get("Access-Control-Request-Method")) {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			for _, h := range utils.SplitList(req.Header.Get("Access-Control-Request-Headers")) {
+				if !containsFold(opts.AllowedHeaders, h) {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+
+			opts.setAllowOrigin(w, origin)
+			w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+			if len(opts.AllowedHeaders) > 0 {
+				w.Header().Set("Access-Control-Allow-Headers", strings.Join(opts.AllowedHeaders, ", "))
+			}
+			if opts.MaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(opts.MaxAge.Seconds())))
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
+
+func (o CORSOptions) setAllowOrigin(w http.ResponseWriter, origin string) {
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	if o.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+func (o CORSOptions) originAllowed(origin string) bool {
+	for _, allowed := range o.AllowedOrigins {
+		if allowed == anyMatch || strings.EqualFold(allowed, origin) {
+			return true
+		}
+		// Wildcard subdomain, e.g., https://*.theraffle.me
+		i := strings.Index(allowed, "://*.")
+		if i < 0 {
+			continue
+		}
+		scheme, suffix := strings.ToLower(allowed[:i+len("://")]), strings.ToLower(allowed[i+len("://*"):])
+		lower := strings.ToLower(origin)
+		if len(lower) <= len(scheme)+len(suffix) || !strings.HasPrefix(lower, scheme) || !strings.HasSuffix(lower, suffix) {
+			continue
+		}
+		if subdomain := lower[len(scheme) : len(lower)-len(suffix)]; !strings.ContainsAny(subdomain, "/:@") {
+			return true
+		}
+	}
+	return false
+}
+
+// routeMethods returns the allowed methods which have a route for the path of req
+func (o CORSOptions) routeMethods(router *mux.Router, req *http.Request) []string {
+	var methods []string
+	for _, m := range o.AllowedMethods {
+		r := req.Clone(req.Context())
+		r.Method = m
+		var match mux.RouteMatch
+		if router.Match(r, &match) {
+			methods = append(methods, m)
+		}
+	}
+	return methods
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/auth"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+const (
+	anyMatch = "*"
+
+	bucketSweepInterval = time.Minute
+)
+
+// Rate is a token bucket of Count tokens, refilled by Count tokens per Period
+type Rate struct {
+	Count  int
+	Period time.Duration
+}
+
+// RateLimitRule is a rate applied to the requests of a method and a route template. "*" matches any
+type RateLimitRule struct {
+	Method string
+	Route  string
+	Rate   Rate
+}
+
+// DefaultRateLimitRules are strict on creating resources and loose on listing projects
+var DefaultRateLimitRules = []RateLimitRule{
+	{Method: http.MethodPost, Route: anyMatch, Rate: Rate{Count: 10, Period: time.Minute}},
+	{Method: http.MethodGet, Route: "/projects", Rate: Rate{Count: 120, Period: time.Minute}},
+	{Method: anyMatch, Route: anyMatch, Rate: Rate{Count: 60, Period: time.Minute}},
+}
+
+// ParseRateLimitRules parses comma-separated rules of "<method> <route>=<count>/<period>"
+// (e.g., "POST /user/{id}/project=5/1m,GET /projects=300/1m")
+func ParseRateLimitRules(spec string) ([]RateLimitRule, error) {
+	var rules []RateLimitRule
+	for _, s := range strings.Split(spec, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		kv := strings.SplitN(s, "=", 2)
+		target := strings.Fields(kv[0])
+		if len(kv) != 2 || len(target) != 2 {
+			return nil, fmt.Errorf("invalid rate limit rule %q", s)
+		}
+		rate := strings.SplitN(kv[1], "/", 2)
+		if len(rate) != 2 {
+			return nil, fmt.Errorf("invalid rate limit rule %q", s)
+		}
+		count, err := strconv.Atoi(rate[0])
+		if err != nil || count <= 0 {
+			return nil, fmt.Errorf("invalid rate limit count %q", rate[0])
+		}
+		period, err := time.ParseDuration(rate[1])
+		if err != nil || period <= 0 {
+			return nil, fmt.Errorf("invalid rate limit period %q", rate[1])
+		}
+		rules = append(rules, RateLimitRule{
+			Method: strings.ToUpper(target[0]),
+			Route:  target[1],
+			Rate:   Rate{Count: count, Period: period},
+		})
+	}
+	return rules, nil
+}
+
+// RateLimitResult is a result of taking a token from a bucket
+type RateLimitResult struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is the time until a token is available, if not allowed
+	RetryAfter time.Duration
+	// Reset is the time until the bucket is full
+	Reset time.Duration
+}
+
+// RateLimitStore stores token buckets. Implement it with a shared store for multi-replica deployments
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, rate Rate) (RateLimitResult, error)
+}
+
+// RateLimit limits the rate of the requests per method and matched route template, keyed by the authenticated user id,
+// or by the client address for unauthenticated requests.
+// The first matching rule in rules is applied. The store errors do not block the requests
+func RateLimit(log logr.Logger, rules []RateLimitRule, store RateLimitStore, router *mux.Router, proxies utils.TrustedProxies) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			route := routeTemplate(router, req)
+			rule := matchRateLimitRule(rules, req.Method, route)
+			if rule == nil {
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			subject := "ip:" + proxies.ClientIP(req)
+			if id, ok := auth.UserID(req.Context()); ok {
+				subject = "user:" + strconv.FormatInt(id, 10)
+			}
+			// Each route has its own buckets, even if the rule matches several routes
+			key := fmt.Sprintf("%s %s|%s", req.Method, route, subject)
+
+			result, err := store.Take(req.Context(), key, rule.Rate)
+			if err != nil {
+				log.Error(err, "rate limit store error", "request_id", utils.RequestID(req))
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(rule.Rate.Count))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			w.Header().Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.Reset)))
+			if !result.Allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+				_ = utils.RespondError(w, http.StatusTooManyRequests, "too many requests")
+				return
+			}
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+func matchRateLimitRule(rules []RateLimitRule, method, route string) *RateLimitRule {
+	for i, r := range rules {
+		if (r.Method == anyMatch || r.Method == method) && (r.Route == anyMatch || r.Route == route) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// MemoryRateLimitStore is an in-memory RateLimitStore, for single-replica deployments
+type MemoryRateLimitStore struct {
+	lock sync.Mutex
+
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Time
+}
+
+// NewMemoryRateLimitStore is a constructor for the MemoryRateLimitStore
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{buckets: map[string]*bucket{}, lastSweep: time.Now()}
+}
+
+// Take takes a token from the bucket of the key
+func (s *MemoryRateLimitStore) Take(_ context.Context, key string, rate Rate) (RateLimitResult, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	capacity := float64(rate.Count)
+	perToken := rate.Period / time.Duration(rate.Count)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, last: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(capacity, b.tokens+float64(now.Sub(b.last))/float64(perToken))
+	b.last = now
+
+	result := RateLimitResult{}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - b.tokens) * float64(perToken))
+	}
+	result.Remaining = int(b.tokens)
+	result.Reset = time.Duration((capacity - b.tokens) * float64(perToken))
+	b.full = now.Add(result.Reset)
+	return result, nil
+}
+
+// sweep removes the buckets which are full, as they are the same as new ones. s.lock must be held
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < bucketSweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if !now.Before(b.full) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+const (
+	requestIDHeader = "X-Request-ID"
+)
+
+var validRequestID = regexp.MustCompile(fmt.Sprintf(`^[A-Za-z0-9._-]{1,%d}$`, utils.MaxRequestIDLength))
+
+// RequestID assigns an id to each request, reusing a well-formed X-Request-ID header of the client.
+// The id is echoed in the response header and can be fetched by utils.RequestID
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		id := req.Header.Get(requestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = utils.RandomString(10)
+		}
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, req.WithContext(utils.WithRequestID(req.Context(), id)))
+	})
+}
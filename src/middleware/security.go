@@ -0,0 +1,138 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package middleware
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// SecurityOptions configures the security headers and the request limits
+type SecurityOptions struct {
+	// HSTSMaxAge is the max-age of Strict-Transport-Security. Zero disables the header
+	HSTSMaxAge     time.Duration
+	ReferrerPolicy string
+	// NoStorePrefixes are the path prefixes whose responses must not be cached
+	NoStorePrefixes []string
+
+	MaxHeaderBytes int
+	MaxBodyBytes   int64
+	// BodyContentTypes are the media types accepted for the bodies of POST, PUT and PATCH requests
+	BodyContentTypes []string
+}
+
+// DefaultSecurityOptions returns the default security options
+func DefaultSecurityOptions() SecurityOptions {
+	return SecurityOptions{
+		HSTSMaxAge:       180 * 24 * time.Hour,
+		ReferrerPolicy:   "no-referrer",
+		NoStorePrefixes:  []string{"/user"},
+		MaxHeaderBytes:   32 << 10,
+		MaxBodyBytes:     1 << 20,
+		BodyContentTypes: utils.BodyContentTypes,
+	}
+}
+
+// SecurityOptionsFromEnv overrides the default security options by HSTS_MAX_AGE, REFERRER_POLICY,
+// MAX_HEADER_BYTES and MAX_BODY_BYTES
+func SecurityOptionsFromEnv() (SecurityOptions, error) {
+	opts := DefaultSecurityOptions()
+	if v := os.Getenv("HSTS_MAX_AGE"); v != "" {
+		maxAge, err := time.ParseDuration(v)
+		if err != nil || maxAge < 0 {
+			return opts, fmt.Errorf("invalid HSTS_MAX_AGE %q", v)
+		}
+		opts.HSTSMaxAge = maxAge
+	}
+	utils.MapEnv(&opts.ReferrerPolicy, "REFERRER_POLICY")
+	if v := os.Getenv("MAX_HEADER_BYTES"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size <= 0 {
+			return opts, fmt.Errorf("invalid MAX_HEADER_BYTES %q", v)
+		}
+		opts.MaxHeaderBytes = size
+	}
+	if v := os.Getenv("MAX_BODY_BYTES"); v != "" {
+		size, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || size <= 0 {
+			return opts, fmt.Errorf("invalid MAX_BODY_BYTES %q", v)
+		}
+		opts.MaxBodyBytes = size
+	}
+	return opts, nil
+}
+
+// Security sets the security headers and rejects the requests whose bodies are too large or not in an accepted media type.
+// The header size is limited by the http server, with MaxHeaderBytes
+func Security(opts SecurityOptions) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			opts.setHeaders(w, req)
+
+			if req.Method == http.MethodPost || req.Method == http.MethodPut || req.Method == http.MethodPatch {
+				if req.ContentLength != 0 && !opts.bodyContentTypeAllowed(req.Header.Get("Content-Type")) {
+					_ = utils.RespondError(w, http.StatusUnsupportedMediaType,
+						fmt.Sprintf("content type must be one of %s", strings.Join(opts.BodyContentTypes, ", ")))
+					return
+				}
+			}
+
+			if opts.MaxBodyBytes > 0 && req.Body != nil {
+				if req.ContentLength > opts.MaxBodyBytes {
+					_ = utils.RespondError(w, http.StatusRequestEntityTooLarge, utils.ErrBodyTooLarge.Error())
+					return
+				}
+				req.Body = http.MaxBytesReader(w, req.Body, opts.MaxBodyBytes)
+			}
+
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+func (o SecurityOptions) setHeaders(w http.ResponseWriter, req *http.Request) {
+	h := w.Header()
+	h.Set("X-Content-Type-Options", "nosniff")
+	if o.HSTSMaxAge > 0 {
+		h.Set("Strict-Transport-Security", fmt.Sprintf("max-age=%d; includeSubDomains", int(o.HSTSMaxAge.Seconds())))
+	}
+	if o.ReferrerPolicy != "" {
+		h.Set("Referrer-Policy", o.ReferrerPolicy)
+	}
+	for _, prefix := range o.NoStorePrefixes {
+		if req.URL.Path == prefix || strings.HasPrefix(req.URL.Path, strings.TrimSuffix(prefix, "/")+"/") {
+			h.Set("Cache-Control", "no-store")
+			break
+		}
+	}
+}
+
+func (o SecurityOptions) bodyContentTypeAllowed(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return containsFold(o.BodyContentTypes, mediaType)
+}
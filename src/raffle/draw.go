@@ -0,0 +1,262 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package raffle
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/theraffle/frontservice/src/chain"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/utils"
+	"google.golang.org/protobuf/proto"
+)
+
+// Scheme is the scheme of the draws
+const Scheme = "commit-reveal-blockhash-keccak256-fisher-yates"
+
+// SeedLength is the length of the seeds of the draws, in bytes
+const SeedLength = 32
+
+// DecodeHex decodes a 0x-prefixed hex string
+func DecodeHex(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return nil, fmt.Errorf("must be 0x-prefixed hex")
+	}
+	return hex.DecodeString(s[2:])
+}
+
+// EncodeHex encodes b as a 0x-prefixed hex string
+func EncodeHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+// ValidateCommitment checks if s is a keccak256 hash in hex
+func ValidateCommitment(s string) error {
+	b, err := DecodeHex(s)
+	if err != nil {
+		return err
+	}
+	if len(b) != 32 {
+		return fmt.Errorf("must be 32 bytes")
+	}
+	return nil
+}
+
+// Commitment returns the commitment of the seed, i.e., its keccak256 hash in hex
+func Commitment(seed []byte) string {
+	return EncodeHex(utils.Keccak256(seed))
+}
+
+// ValidateFrozenFields checks if the project may change from current to next. The seed commitment, the number of
+// the winners, the chain and the eligibility rules are fixed once the project opens, so that they cannot be chosen
+// for the entries. E.g., the owner, who knows the seed, could otherwise pick max winners to include or drop an entry.
+// The end time is fixed once the project closes, since it decides the block of the draw (see CheckDrawBlock)
+func ValidateFrozenFields(current, next *pb.Project) error {
+	if current.Status == pb.ProjectStatus_DRAFT || current.Status == pb.ProjectStatus_UPCOMING {
+		return nil
+	}
+	if current.Status != pb.ProjectStatus_OPEN && !proto.Equal(current.EndTime, next.EndTime) {
+		return fmt.Errorf("end_time of %s project cannot be changed", current.Status)
+	}
+	if !strings.EqualFold(current.SeedCommitment, next.SeedCommitment) {
+		return fmt.Errorf("seed_commitment of %s project cannot be changed", current.Status)
+	}
+	if current.MaxWinners != next.MaxWinners {
+		return fmt.Errorf("max_winners of %s project cannot be changed", current.Status)
+	}
+	if current.ChainID != next.ChainID {
+		return fmt.Errorf("chain_id of %s project cannot be changed", current.Status)
+	}
+	if !proto.Equal(current.Eligibility, next.Eligibility) {
+		return fmt.Errorf("eligibility of %s project cannot be changed", current.Status)
+	}
+	return nil
+}
+
+// SortEntries sorts the entries by the user id, the chain id and then the address, case-insensitively
+func SortEntries(entries []*pb.ProjectEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.UserID != b.UserID {
+			return a.UserID < b.UserID
+		}
+		if a.ChainID != b.ChainID {
+			return a.ChainID < b.ChainID
+		}
+		return strings.ToLower(a.Address) < strings.ToLower(b.Address)
+	})
+}
+
+// EntriesHash returns the keccak256 hash of the sorted entries, each written as "userID:chainID:address\n"
+// with the address in lower case
+func EntriesHash(entries []*pb.ProjectEntry) []byte {
+	var buf bytes.Buffer
+	for _, e := range entries {
+		fmt.Fprintf(&buf, "%d:%d:%s\n", e.UserID, e.ChainID, strings.ToLower(e.Address))
+	}
+	return utils.Keccak256(buf.Bytes())
+}
+
+// CheckDrawBlock checks if block is the block of the draw of the project, i.e., the first block after the end time of
+// the project, whose parent block is parent (nil for the genesis block). Its hash is unknown until the project closes,
+// so that the owner, who knows the seed, cannot compute the winners while the entries can still change
+func CheckDrawBlock(project *pb.Project, block, parent *chain.Block) error {
+	if project.EndTime == nil {
+		return fmt.Errorf("project has no end_time")
+	}
+	endTime := project.EndTime.AsTime()
+	if !block.Time.After(endTime) {
+		return fmt.Errorf("block %d is not after end_time of the project", block.Number)
+	}
+	if parent != nil && parent.Time.After(endTime) {
+		return fmt.Errorf("block %d is not the first block after end_time of the project", block.Number)
+	}
+	return nil
+}
+
+// Draw draws up to maxWinners winners of the entries with the seed and the block, and returns them with the proof.
+// The entries are sorted in place.
+//
+// The randomness is keccak256(seed || blockHash || entriesHash), and the winners are the first ones of the entries
+// shuffled by Fisher-Yates, where the i-th swap is with the (i + uniform(n - i))-th entry. The uniform numbers are
+// taken from the big-endian uint64s of the blocks keccak256(randomness || uint64(k)), k = 0, 1, ..., rejecting the
+// ones which would bias the modulo
+func Draw(seed []byte, commitment string, block *chain.Block, entries []*pb.ProjectEntry, maxWinners int) (*pb.DrawProof, []*pb.ProjectEntry) {
+	SortEntries(entries)
+	entriesHash := EntriesHash(entries)
+	blockHash, _ := DecodeHex(block.Hash)
+	randomness := utils.Keccak256(seed, blockHash, entriesHash)
+
+	shuffled := make([]*pb.ProjectEntry, len(entries))
+	copy(shuffled, entries)
+	rng := &stream{randomness: randomness}
+	n := len(shuffled)
+	k := maxWinners
+	if k > n {
+		k = n
+	}
+	for i := 0; i < k; i++ {
+		j := i + int(rng.uniform(uint64(n-i)))
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	}
+
+	proof := &pb.DrawProof{
+		Scheme:         Scheme,
+		SeedCommitment: commitment,
+		EntriesHash:    EncodeHex(entriesHash),
+		EntryCount:     int64(n),
+		Randomness:     EncodeHex(randomness),
+		BlockNumber:    int64(block.Number),
+		BlockHash:      block.Hash,
+	}
+	return proof, shuffled[:k]
+}
+
+// stream is the deterministic stream of the random numbers of a draw
+type stream struct {
+	randomness []byte
+	counter    uint64
+	buf        []byte
+}
+
+func (s *stream) next() uint64 {
+	if len(s.buf) == 0 {
+		var counter [8]byte
+		binary.BigEndian.PutUint64(counter[:], s.counter)
+		s.counter++
+		s.buf = utils.Keccak256(s.randomness, counter[:])
+	}
+	v := binary.BigEndian.Uint64(s.buf[:8])
+	s.buf = s.buf[8:]
+	return v
+}
+
+// uniform returns a uniform random number in [0, n)
+func (s *stream) uniform(n uint64) uint64 {
+	// 2^64 mod n numbers at the bottom are rejected, so that the rest is a multiple of n
+	threshold := -n % n
+	for {
+		if v := s.next(); v >= threshold {
+			return v % n
+		}
+	}
+}
+
+// Verify recomputes the draw of the project from its seed, the block and the entries, and reports the parts of the draw
+// which are not reproduced. block and parent are the block of blockNumber of the draw and its parent on the chain,
+// and block is nil if the chain does not have it
+func Verify(draw *pb.ProjectDraw, project *pb.Project, block, parent *chain.Block, entries []*pb.ProjectEntry) *pb.DrawVerification {
+	verification := &pb.DrawVerification{}
+	mismatch := func(part string) {
+		verification.Mismatches = append(verification.Mismatches, part)
+	}
+	claimed := draw.Proof
+	if claimed == nil {
+		claimed = &pb.DrawProof{}
+	}
+	seed, err := DecodeHex(draw.Seed)
+	if err != nil || !strings.EqualFold(Commitment(seed), claimed.SeedCommitment) ||
+		!strings.EqualFold(claimed.SeedCommitment, project.SeedCommitment) {
+		mismatch("seed_commitment")
+	}
+
+	if block == nil || !strings.EqualFold(claimed.BlockHash, block.Hash) || CheckDrawBlock(project, block, parent) != nil {
+		mismatch("block")
+	}
+	if block == nil {
+		block = &chain.Block{Number: uint64(claimed.BlockNumber), Hash: claimed.BlockHash}
+	}
+
+	proof, winners := Draw(seed, claimed.SeedCommitment, block, entries, len(draw.Winners))
+	verification.Proof, verification.Winners = proof, winners
+	if claimed.Scheme != proof.Scheme {
+		mismatch("scheme")
+	}
+	if claimed.EntryCount != proof.EntryCount {
+		mismatch("entry_count")
+	}
+	if !strings.EqualFold(claimed.EntriesHash, proof.EntriesHash) {
+		mismatch("entries_hash")
+	}
+	if !strings.EqualFold(claimed.Randomness, proof.Randomness) {
+		mismatch("randomness")
+	}
+	expected := int64(project.MaxWinners)
+	if proof.EntryCount < expected {
+		expected = proof.EntryCount
+	}
+	if int64(len(draw.Winners)) != expected {
+		mismatch("winner_count")
+	}
+	for i, w := range draw.Winners {
+		if i >= len(winners) || !sameEntry(w, winners[i]) {
+			mismatch("winners")
+			break
+		}
+	}
+	verification.Verified = len(verification.Mismatches) == 0
+	return verification
+}
+
+func sameEntry(a, b *pb.ProjectEntry) bool {
+	return a.UserID == b.UserID && a.ChainID == b.ChainID && strings.EqualFold(a.Address, b.Address)
+}
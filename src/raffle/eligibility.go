@@ -0,0 +1,172 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package raffle
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/theraffle/frontservice/src/genproto/pb"
+)
+
+// Rules of the entries, which the rejections report
+const (
+	RuleProjectChain       = "project_chain"
+	RuleRequiredAccounts   = "required_accounts"
+	RuleMinAccountAge      = "min_account_age"
+	RuleLinkedWallet       = "linked_wallet"
+	RuleOneEntryPerUser    = "one_entry_per_user"
+	RuleOneEntryPerAddress = "one_entry_per_address"
+)
+
+// IneligibleError is the rejection of an entry by a rule
+type IneligibleError struct {
+	Rule    string
+	Message string
+}
+
+// Error returns the message of the rejection
+func (e *IneligibleError) Error() string {
+	return e.Message
+}
+
+func ineligible(rule, format string, args ...interface{}) error {
+	return &IneligibleError{Rule: rule, Message: fmt.Sprintf(format, args...)}
+}
+
+// Entrant is what the eligibility of an entry is checked against. User and Wallets are only needed by the rules
+// which require them, and AddressEntries are the entries of the project with the address of the entry
+type Entrant struct {
+	User           *pb.GetUserResponse
+	Wallets        []*pb.UserWallet
+	UserEntries    *pb.GetUserProjectResponse
+	AddressEntries []*pb.ProjectEntry
+}
+
+// NeedsUser returns true if the rules of the project need the user of the entrant
+func NeedsUser(rules *pb.EligibilityRules) bool {
+	return len(rules.GetRequiredAccounts()) > 0 || rules.GetMinAccountAge().AsDuration() > 0
+}
+
+// ValidateEligibility checks the eligibility rules
+func ValidateEligibility(rules *pb.EligibilityRules) error {
+	if rules == nil {
+		return nil
+	}
+	for _, account := range rules.RequiredAccounts {
+		if _, ok := pb.LoginType_name[int32(account)]; !ok {
+			return fmt.Errorf("invalid required account %d", account)
+		}
+	}
+	if rules.MinAccountAge != nil {
+		if err := rules.MinAccountAge.CheckValid(); err != nil || rules.MinAccountAge.AsDuration() < 0 {
+			return fmt.Errorf("min_account_age must be a non-negative duration")
+		}
+	}
+	return nil
+}
+
+// CheckEligibility checks if the entry of the user is eligible for the project at now.
+// The error is an IneligibleError with the rule which rejects the entry
+func CheckEligibility(project *pb.Project, entry *pb.ProjectEntry, entrant Entrant, now time.Time) error {
+	if entry.ChainID != project.ChainID {
+		return ineligible(RuleProjectChain, "entry must be on chain %d of the project", project.ChainID)
+	}
+
+	rules := project.Eligibility
+	for _, account := range rules.GetRequiredAccounts() {
+		if linkedAccount(entrant.User, account) == "" {
+			return ineligible(RuleRequiredAccounts, "%s account must be linked", account)
+		}
+	}
+	if minAge := rules.GetMinAccountAge().AsDuration(); minAge > 0 {
+		createdAt := entrant.User.GetCreatedAt()
+		if createdAt == nil {
+			return ineligible(RuleMinAccountAge, "account must be at least %s old, but its age is unknown", minAge)
+		}
+		if now.Sub(createdAt.AsTime()) < minAge {
+			return ineligible(RuleMinAccountAge, "account must be at least %s old", minAge)
+		}
+	}
+	if rules.GetRequireWallet() && !linkedWallet(entrant.Wallets, entry) {
+		return ineligible(RuleLinkedWallet, "wallet %s on chain %d must be linked", entry.Address, entry.ChainID)
+	}
+
+	if HasEntered(entrant.UserEntries, project.ProjectID) {
+		return ineligible(RuleOneEntryPerUser, "user has already entered the project")
+	}
+	for _, e := range entrant.AddressEntries {
+		if e.ProjectID == project.ProjectID && strings.EqualFold(e.Address, entry.Address) {
+			return ineligible(RuleOneEntryPerAddress, "address %s has already entered the project", entry.Address)
+		}
+	}
+	return nil
+}
+
+// CheckEntryChange checks if the entry of the user in the project may be changed to entry. The new address must be
+// a wallet of the user on the chain of the project, which has not entered the project by the other users
+func CheckEntryChange(project *pb.Project, entry *pb.ProjectEntry, entrant Entrant) error {
+	if entry.ChainID != project.ChainID {
+		return ineligible(RuleProjectChain, "entry must be on chain %d of the project", project.ChainID)
+	}
+	if !linkedWallet(entrant.Wallets, entry) {
+		return ineligible(RuleLinkedWallet, "wallet %s on chain %d must be linked", entry.Address, entry.ChainID)
+	}
+	for _, e := range entrant.AddressEntries {
+		if e.ProjectID == project.ProjectID && e.UserID != entry.UserID && strings.EqualFold(e.Address, entry.Address) {
+			return ineligible(RuleOneEntryPerAddress, "address %s has already entered the project", entry.Address)
+		}
+	}
+	return nil
+}
+
+// HasEntered returns true if the entries of the user have the project
+func HasEntered(userEntries *pb.GetUserProjectResponse, projectID int64) bool {
+	for _, id := range userEntries.GetProjects() {
+		if id == projectID {
+			return true
+		}
+	}
+	for _, e := range userEntries.GetEntries() {
+		if e.ProjectID == projectID {
+			return true
+		}
+	}
+	return false
+}
+
+func linkedAccount(user *pb.GetUserResponse, account pb.LoginType) string {
+	switch account {
+	case pb.LoginType_DISCORD:
+		return user.GetDiscordID()
+	case pb.LoginType_TELEGRAM:
+		return user.GetTelegramID()
+	case pb.LoginType_TWITTER:
+		return user.GetTwitterID()
+	}
+	return ""
+}
+
+func linkedWallet(wallets []*pb.UserWallet, entry *pb.ProjectEntry) bool {
+	for _, w := range wallets {
+		if w.ChainID == entry.ChainID && strings.EqualFold(w.Address, entry.Address) {
+			return true
+		}
+	}
+	return false
+}
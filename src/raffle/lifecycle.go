@@ -0,0 +1,127 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package raffle implements the rules of the raffles, which the gateway enforces in front of the services
+package raffle
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/theraffle/frontservice/src/genproto/pb"
+)
+
+// transitions are the statuses a project may move to, from each status. DRAWN and CANCELLED are final
+var transitions = map[pb.ProjectStatus][]pb.ProjectStatus{
+	pb.ProjectStatus_DRAFT:    {pb.ProjectStatus_UPCOMING, pb.ProjectStatus_CANCELLED},
+	pb.ProjectStatus_UPCOMING: {pb.ProjectStatus_DRAFT, pb.ProjectStatus_OPEN, pb.ProjectStatus_CANCELLED},
+	pb.ProjectStatus_OPEN:     {pb.ProjectStatus_CLOSED, pb.ProjectStatus_CANCELLED},
+	pb.ProjectStatus_CLOSED:   {pb.ProjectStatus_DRAWN, pb.ProjectStatus_CANCELLED},
+}
+
+// InitialStatuses are the statuses a project may be created with
+var InitialStatuses = []pb.ProjectStatus{pb.ProjectStatus_DRAFT, pb.ProjectStatus_UPCOMING}
+
+// EffectiveStatus returns the status of the project at now, following its schedule.
+// An upcoming project opens at its start time, and an upcoming or open project closes at its end time
+func EffectiveStatus(project *pb.Project, now time.Time) pb.ProjectStatus {
+	status := project.Status
+	if status != pb.ProjectStatus_UPCOMING && status != pb.ProjectStatus_OPEN {
+		return status
+	}
+	if project.EndTime != nil && !now.Before(project.EndTime.AsTime()) {
+		return pb.ProjectStatus_CLOSED
+	}
+	if status == pb.ProjectStatus_UPCOMING && project.StartTime != nil && !now.Before(project.StartTime.AsTime()) {
+		return pb.ProjectStatus_OPEN
+	}
+	return status
+}
+
+// ApplyEffectiveStatus sets the status of the project to its effective status at now
+func ApplyEffectiveStatus(project *pb.Project, now time.Time) {
+	if project != nil {
+		project.Status = EffectiveStatus(project, now)
+	}
+}
+
+// ValidateTransition checks if a project may move from the status from to the status to
+func ValidateTransition(from, to pb.ProjectStatus) error {
+	if from == to {
+		return nil
+	}
+	for _, s := range transitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("project cannot move from %s to %s", from, to)
+}
+
+// ValidateSchedule checks the schedule of the project. Projects which are upcoming or open need
+// their window, which ends after now, the maximum number of the winners and the seed commitment of the draw
+func ValidateSchedule(project *pb.Project, now time.Time) error {
+	if _, ok := pb.ProjectStatus_name[int32(project.Status)]; !ok {
+		return fmt.Errorf("invalid status %d", project.Status)
+	}
+	if project.MaxWinners < 0 {
+		return fmt.Errorf("max_winners must not be negative")
+	}
+	if project.StartTime != nil && project.EndTime != nil && !project.StartTime.AsTime().Before(project.EndTime.AsTime()) {
+		return fmt.Errorf("start_time must be before end_time")
+	}
+	if project.Status != pb.ProjectStatus_UPCOMING && project.Status != pb.ProjectStatus_OPEN {
+		return nil
+	}
+	if project.StartTime == nil || project.EndTime == nil {
+		return fmt.Errorf("%s project requires start_time and end_time", project.Status)
+	}
+	if !now.Before(project.EndTime.AsTime()) {
+		return fmt.Errorf("end_time of %s project must be in the future", project.Status)
+	}
+	if project.MaxWinners == 0 {
+		return fmt.Errorf("%s project requires max_winners", project.Status)
+	}
+	if project.SeedCommitment == "" {
+		return fmt.Errorf("%s project requires seed_commitment", project.Status)
+	}
+	return nil
+}
+
+// CheckOpen checks if the project accepts entries at now. The error explains why it does not
+func CheckOpen(project *pb.Project, now time.Time) error {
+	switch EffectiveStatus(project, now) {
+	case pb.ProjectStatus_OPEN:
+		return nil
+	case pb.ProjectStatus_UPCOMING:
+		if project.StartTime != nil {
+			return fmt.Errorf("project is not open for entries until %s", project.StartTime.AsTime().UTC().Format(time.RFC3339))
+		}
+		return fmt.Errorf("project is not open for entries yet")
+	case pb.ProjectStatus_DRAFT:
+		return fmt.Errorf("project is not open for entries yet")
+	case pb.ProjectStatus_CLOSED:
+		if project.EndTime != nil {
+			return fmt.Errorf("project was closed for entries at %s", project.EndTime.AsTime().UTC().Format(time.RFC3339))
+		}
+		return fmt.Errorf("project is closed for entries")
+	case pb.ProjectStatus_DRAWN:
+		return fmt.Errorf("project has already been drawn")
+	case pb.ProjectStatus_CANCELLED:
+		return fmt.Errorf("project has been cancelled")
+	}
+	return fmt.Errorf("project is not open for entries")
+}
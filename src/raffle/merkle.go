@@ -0,0 +1,102 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package raffle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// MerkleTree is a merkle tree of keccak256 hashes with sorted pairs, whose proofs are verified by
+// OpenZeppelin's MerkleProof. A node without its pair is moved to the upper level as it is
+type MerkleTree struct {
+	// levels are the nodes from the leaves to the root
+	levels [][][]byte
+}
+
+// AllowlistLeaf returns the leaf of the address, i.e., keccak256(abi.encodePacked(address))
+func AllowlistLeaf(address string) []byte {
+	b, _ := hex.DecodeString(strings.TrimPrefix(strings.ToLower(address), "0x"))
+	return utils.Keccak256(b)
+}
+
+// NewMerkleTree builds a merkle tree of the leaves. The leaves are sorted, and the duplicated ones are removed
+func NewMerkleTree(leaves [][]byte) *MerkleTree {
+	level := make([][]byte, 0, len(leaves))
+	level = append(level, leaves...)
+	sort.Slice(level, func(i, j int) bool { return bytes.Compare(level[i], level[j]) < 0 })
+	unique := level[:0]
+	for i, leaf := range level {
+		if i == 0 || !bytes.Equal(leaf, level[i-1]) {
+			unique = append(unique, leaf)
+		}
+	}
+
+	tree := &MerkleTree{levels: [][][]byte{unique}}
+	for level := unique; len(level) > 1; {
+		var next [][]byte
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashPair(level[i], level[i+1]))
+		}
+		tree.levels = append(tree.levels, next)
+		level = next
+	}
+	return tree
+}
+
+// hashPair hashes the pair of the nodes in the sorted order
+func hashPair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return utils.Keccak256(a, b)
+}
+
+// Root returns the root of the tree, or nil if the tree has no leaves
+func (t *MerkleTree) Root() []byte {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
+		return nil
+	}
+	return top[0]
+}
+
+// Proof returns the proof of the leaf, i.e., the siblings from the leaf to the root. It returns false if the tree
+// does not have the leaf
+func (t *MerkleTree) Proof(leaf []byte) ([][]byte, bool) {
+	leaves := t.levels[0]
+	index := sort.Search(len(leaves), func(i int) bool { return bytes.Compare(leaves[i], leaf) >= 0 })
+	if index == len(leaves) || !bytes.Equal(leaves[index], leaf) {
+		return nil, false
+	}
+	proof := [][]byte{}
+	for _, level := range t.levels[:len(t.levels)-1] {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof, true
+}
@@ -0,0 +1,133 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/theraffle/frontservice/src/apihandler"
+	"github.com/theraffle/frontservice/src/auth"
+	"github.com/theraffle/frontservice/src/logging"
+	"github.com/theraffle/frontservice/src/utils"
+	"github.com/theraffle/frontservice/src/wrapper"
+	"google.golang.org/grpc"
+	"net/http"
+	"os"
+)
+
+type handler struct {
+	ctx context.Context
+	log logr.Logger
+
+	levels         *logging.Levels
+	projectSvcConn *grpc.ClientConn
+}
+
+type logLevelReqBody struct {
+	Logger string `json:"logger,omitempty"`
+	Level  string `json:"level"`
+}
+
+type logLevelResponse struct {
+	Default string            `json:"default"`
+	Loggers map[string]string `json:"loggers"`
+}
+
+// NewHandler instantiates a new admin apis handler.
+// Admin apis are only served if ADMIN_TOKEN is set, with the token as a bearer token
+func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.Logger, levels *logging.Levels, projectSvcConn *grpc.ClientConn) (apihandler.APIHandler, error) {
+	handler := &handler{ctx: ctx, log: logger, levels: levels, projectSvcConn: projectSvcConn}
+	token := os.Getenv("ADMIN_TOKEN")
+	if token == "" {
+		logger.Info("ADMIN_TOKEN is not set, admin apis are disabled")
+		return handler, nil
+	}
+
+	adminWrapper := wrapper.New("/admin", nil, nil)
+	if err := parent.Add(adminWrapper); err != nil {
+		return nil, err
+	}
+	adminWrapper.Router().Use(auth.RequireToken(token))
+
+	// Get Log Levels
+	getLogLevel := wrapper.New("/loglevel", []string{http.MethodGet}, handler.getLogLevelHandler)
+	if err := adminWrapper.Add(getLogLevel); err != nil {
+		return nil, err
+	}
+
+	// Set Log Level
+	setLogLevel := wrapper.New("/loglevel", []string{http.MethodPut}, handler.setLogLevelHandler)
+	if err := adminWrapper.Add(setLogLevel); err != nil {
+		return nil, err
+	}
+
+	// Set Project Owner
+	setProjectOwner := wrapper.New("/project/{id}/owner", []string{http.MethodPut}, handler.setProjectOwnerHandler)
+	if err := adminWrapper.Add(setProjectOwner); err != nil {
+		return nil, err
+	}
+
+	return handler, nil
+}
+
+func (h *handler) getLogLevelHandler(w http.ResponseWriter, _ *http.Request) {
+	_ = utils.RespondJSON(w, h.logLevels())
+}
+
+// setLogLevelHandler sets the level of a logger. An empty logger sets the default level,
+// and an empty level resets the logger to follow its parent
+func (h *handler) setLogLevelHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("set_log_level_request", reqID)
+
+	// Decode request body
+	setLogLevelReq := &logLevelReqBody{}
+	if err := utils.DecodeJSON(req, setLogLevelReq); err != nil {
+		h.log.Error(err, "set log level error")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
+		return
+	}
+
+	if setLogLevelReq.Level == "" {
+		if setLogLevelReq.Logger == "" {
+			_ = utils.RespondError(w, http.StatusBadRequest, "level not specified")
+			return
+		}
+		log.Info("resetting log level", "logger", setLogLevelReq.Logger)
+		h.levels.ResetLevel(setLogLevelReq.Logger)
+		_ = utils.RespondJSON(w, h.logLevels())
+		return
+	}
+
+	lvl, err := logging.ParseLevel(setLogLevelReq.Level)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	log.Info("setting log level", "logger", setLogLevelReq.Logger, "level", lvl.String())
+	h.levels.SetLevel(setLogLevelReq.Logger, lvl)
+	_ = utils.RespondJSON(w, h.logLevels())
+}
+
+func (h *handler) logLevels() *logLevelResponse {
+	defaultLevel, named := h.levels.Snapshot()
+	resp := &logLevelResponse{Default: defaultLevel.String(), Loggers: map[string]string{}}
+	for name, lvl := range named {
+		resp.Loggers[name] = lvl.String()
+	}
+	return resp
+}
@@ -0,0 +1,78 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/raffle"
+	"github.com/theraffle/frontservice/src/utils"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type projectOwnerReqBody struct {
+	OwnerID int64 `json:"owner_id"`
+}
+
+// setProjectOwnerHandler sets the owner of the project, e.g., of the projects created before the owners were recorded
+func (h *handler) setProjectOwnerHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("set_project_owner_request", reqID)
+
+	projectID, err := strconv.ParseInt(mux.Vars(req)["id"], 10, 64)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	// Decode request body
+	setProjectOwnerReq := &projectOwnerReqBody{}
+	if err := utils.DecodeJSON(req, setProjectOwnerReq); err != nil {
+		h.log.Error(err, "set project owner error")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
+		return
+	}
+	if setProjectOwnerReq.OwnerID <= 0 {
+		_ = utils.RespondError(w, http.StatusBadRequest, "owner_id must be a positive integer")
+		return
+	}
+
+	projectSvcCli := pb.NewProjectServiceClient(h.projectSvcConn)
+	current, err := projectSvcCli.GetProject(h.ctx, &pb.GetProjectRequest{ProjectID: projectID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+
+	log.Info("setting project owner", "project_id", projectID, "from", current.Project.OwnerID, "to", setProjectOwnerReq.OwnerID)
+	resp, err := projectSvcCli.UpdateProject(h.ctx, &pb.UpdateProjectRequest{
+		ProjectID:  projectID,
+		Project:    &pb.Project{ProjectID: projectID, OwnerID: setProjectOwnerReq.OwnerID},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"ownerID"}},
+	})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	raffle.ApplyEffectiveStatus(resp.Project, time.Now())
+	_ = utils.Respond(w, req, resp)
+}
@@ -0,0 +1,270 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package batch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/theraffle/frontservice/src/apihandler"
+	"github.com/theraffle/frontservice/src/utils"
+	"github.com/theraffle/frontservice/src/wrapper"
+)
+
+const batchPath = "/batch"
+
+// Options configures the batch requests
+type Options struct {
+	// MaxSize is the maximum number of the sub-requests in a batch
+	MaxSize int
+	// Concurrency is the maximum number of the sub-requests of a batch served at a time
+	Concurrency int
+}
+
+// DefaultOptions returns the default batch options
+func DefaultOptions() Options {
+	return Options{
+		MaxSize:     20,
+		Concurrency: 4,
+	}
+}
+
+// OptionsFromEnv overrides the default batch options by BATCH_MAX_SIZE and BATCH_CONCURRENCY
+func OptionsFromEnv() (Options, error) {
+	opts := DefaultOptions()
+	for env, target := range map[string]*int{
+		"BATCH_MAX_SIZE":    &opts.MaxSize,
+		"BATCH_CONCURRENCY": &opts.Concurrency,
+	} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return opts, fmt.Errorf("invalid %s %q", env, v)
+		}
+		*target = n
+	}
+	return opts, nil
+}
+
+type handler struct {
+	ctx context.Context
+	log logr.Logger
+
+	opts     Options
+	dispatch http.Handler
+}
+
+type subRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	// Headers are the conditional headers of the sub-request, e.g., If-Match
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// conditionalHeaders are the headers which are given per sub-request, instead of being copied from the batch request
+var conditionalHeaders = []string{"If-Match", "If-None-Match", "If-Modified-Since", "If-Unmodified-Since"}
+
+type subResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers"`
+	Body    interface{}       `json:"body,omitempty"`
+}
+
+// NewHandler instantiates a new batch apis handler. Sub-requests are served by dispatch,
+// which should be the handler of the server including its middlewares
+func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.Logger, dispatch http.Handler) (apihandler.APIHandler, error) {
+	handler := &handler{ctx: ctx, log: logger, dispatch: dispatch}
+	opts, err := OptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	handler.opts = opts
+
+	// Batch Requests
+	batch := wrapper.New(batchPath, []string{http.MethodPost}, handler.batchHandler)
+	if err := parent.Add(batch); err != nil {
+		return nil, err
+	}
+
+	return handler, nil
+}
+
+func (h *handler) batchHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("batch_request", reqID)
+
+	var subReqs []subRequest
+	if err := utils.DecodeJSON(req, &subReqs); err != nil {
+		h.log.Error(err, "batch error")
+		_ = utils.RespondDecodeError(w, err, "request body is not a json array of requests")
+		return
+	}
+	if len(subReqs) == 0 || len(subReqs) > h.opts.MaxSize {
+		_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("batch must have 1 to %d requests", h.opts.MaxSize))
+		return
+	}
+	for i, r := range subReqs {
+		if err := r.validate(); err != nil {
+			_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("request %d: %v", i, err))
+			return
+		}
+	}
+
+	log.Info("serving batch", "size", len(subReqs))
+
+	responses := make([]subResponse, len(subReqs))
+	fanOut := utils.FanOutOptions{Concurrency: h.opts.Concurrency}
+	fanOut.FanOut(req.Context(), len(subReqs), func(ctx context.Context, i int) {
+		responses[i] = h.serve(ctx, req, reqID, i, subReqs[i])
+	})
+	_ = utils.RespondJSON(w, responses)
+}
+
+func (r subRequest) validate() error {
+	switch strings.ToUpper(r.Method) {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return fmt.Errorf("method must be one of GET, POST, PUT, PATCH and DELETE")
+	}
+	u, err := url.ParseRequestURI(r.Path)
+	if err != nil || u.Scheme != "" || u.Host != "" || !strings.HasPrefix(r.Path, "/") {
+		return fmt.Errorf("path must be an absolute path of the server")
+	}
+	if strings.TrimRight(u.Path, "/") == batchPath {
+		return fmt.Errorf("batch requests cannot be nested")
+	}
+	for key := range r.Headers {
+		if !containsFold(conditionalHeaders, key) {
+			return fmt.Errorf("header %s is not allowed", key)
+		}
+	}
+	return nil
+}
+
+// subRequestID returns the id of the i-th sub-request, i.e., the id of the batch request followed by the index.
+// The id of the batch request is truncated for the index to fit in the maximum length of the request ids
+func subRequestID(reqID string, i int) string {
+	suffix := "." + strconv.Itoa(i)
+	if max := utils.MaxRequestIDLength - len(suffix); len(reqID) > max {
+		reqID = reqID[:max]
+	}
+	return reqID + suffix
+}
+
+// serve dispatches the sub-request in-process, with the headers of the batch request (e.g., the authentication)
+func (h *handler) serve(ctx context.Context, batchReq *http.Request, reqID string, i int, r subRequest) subResponse {
+	var body []byte
+	if len(r.Body) > 0 && string(r.Body) != "null" {
+		body = r.Body
+	}
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(r.Method), r.Path, bytes.NewReader(body))
+	if err != nil {
+		return subResponse{Status: http.StatusBadRequest, Body: utils.ErrorResponse{Message: err.Error()}}
+	}
+	req.RemoteAddr = batchReq.RemoteAddr
+	req.Host = batchReq.Host
+	req.Header = batchReq.Header.Clone()
+	for _, key := range append([]string{"Content-Type", "Content-Length", "Content-Encoding", "Accept-Encoding"}, conditionalHeaders...) {
+		req.Header.Del(key)
+	}
+	for key, value := range r.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Accept", utils.ContentTypeJSON)
+	req.Header.Set("X-Request-ID", subRequestID(reqID, i))
+	if body != nil {
+		req.Header.Set("Content-Type", utils.ContentTypeJSON)
+	}
+
+	rec := newRecorder()
+	h.dispatch.ServeHTTP(rec, req)
+	return rec.response()
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// recorder records the response of a sub-request
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newRecorder() *recorder {
+	return &recorder{header: http.Header{}}
+}
+
+// Header returns the response headers
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+// WriteHeader records the status code
+func (r *recorder) WriteHeader(code int) {
+	if r.status == 0 {
+		r.status = code
+	}
+}
+
+// Write records the body
+func (r *recorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(b)
+}
+
+// response converts the recorded response. JSON bodies are embedded as they are, and other bodies as strings
+func (r *recorder) response() subResponse {
+	resp := subResponse{Status: r.status, Headers: map[string]string{}}
+	if resp.Status == 0 {
+		resp.Status = http.StatusOK
+	}
+	for key, values := range r.header {
+		resp.Headers[key] = strings.Join(values, ", ")
+	}
+	if r.body.Len() == 0 {
+		return resp
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.header.Get("Content-Type"))
+	if mediaType == utils.ContentTypeJSON && json.Valid(r.body.Bytes()) {
+		resp.Body = json.RawMessage(r.body.Bytes())
+	} else {
+		resp.Body = r.body.String()
+	}
+	return resp
+}
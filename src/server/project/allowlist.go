@@ -0,0 +1,119 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package project
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/raffle"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// allowlist builds the allowlist of the project from its draw. It responds the error and returns nil if it cannot
+func (h *handler) allowlist(w http.ResponseWriter, projectID int64) (*pb.Allowlist, *raffle.MerkleTree) {
+	draw, err := pb.NewProjectServiceClient(h.projectSvcConn).GetProjectDraw(h.ctx, &pb.GetProjectDrawRequest{ProjectID: projectID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return nil, nil
+	}
+	if len(draw.Winners) == 0 {
+		_ = utils.RespondError(w, http.StatusNotFound, "project has no winners")
+		return nil, nil
+	}
+
+	allowlist := &pb.Allowlist{ProjectID: projectID}
+	seen := map[string]bool{}
+	var leaves [][]byte
+	for _, winner := range draw.Winners {
+		address := utils.ChecksumAddress(winner.Address)
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		allowlist.Addresses = append(allowlist.Addresses, address)
+		leaves = append(leaves, raffle.AllowlistLeaf(address))
+	}
+	sort.Slice(allowlist.Addresses, func(i, j int) bool {
+		return strings.ToLower(allowlist.Addresses[i]) < strings.ToLower(allowlist.Addresses[j])
+	})
+	tree := raffle.NewMerkleTree(leaves)
+	allowlist.Root = raffle.EncodeHex(tree.Root())
+	return allowlist, tree
+}
+
+// getAllowlistHandler responds the merkle root of the addresses of the winners
+func (h *handler) getAllowlistHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("get_allowlist_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	log.Info("getting allowlist", "id", id)
+	intID, _ := strconv.Atoi(id)
+	allowlist, _ := h.allowlist(w, int64(intID))
+	if allowlist == nil {
+		return
+	}
+	_ = utils.Respond(w, req, allowlist)
+}
+
+// getAllowlistProofHandler responds the merkle proof of an address of a winner
+func (h *handler) getAllowlistProofHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("get_allowlist_proof_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	address := mux.Vars(req)["address"]
+	if err := utils.ValidateAddress(address); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, "invalid address: "+err.Error())
+		return
+	}
+	log.Info("getting allowlist proof", "id", id, "address", address)
+	intID, _ := strconv.Atoi(id)
+	allowlist, tree := h.allowlist(w, int64(intID))
+	if allowlist == nil {
+		return
+	}
+	leaf := raffle.AllowlistLeaf(address)
+	proof, ok := tree.Proof(leaf)
+	if !ok {
+		_ = utils.RespondError(w, http.StatusNotFound, "address is not in the allowlist")
+		return
+	}
+	resp := &pb.AllowlistProof{
+		ProjectID: allowlist.ProjectID,
+		Root:      allowlist.Root,
+		Address:   utils.ChecksumAddress(address),
+		Leaf:      raffle.EncodeHex(leaf),
+		Proof:     []string{},
+	}
+	for _, p := range proof {
+		resp.Proof = append(resp.Proof, raffle.EncodeHex(p))
+	}
+	_ = utils.Respond(w, req, resp)
+}
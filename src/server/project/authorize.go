@@ -0,0 +1,158 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/auth"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// policy is what an api of the projects requires of the authenticated user
+type policy struct {
+	// public is true if the api is served without the authentication
+	public bool
+	// authenticated is true if the api requires only the authentication, i.e., the creation of a project
+	authenticated bool
+	// role is the least role of the user in the project
+	role pb.ProjectRole
+}
+
+// policies are the policies of the apis of the projects, by their path templates and methods. The other apis,
+// including the transcoded ones, are denied until their policies are added here
+var policies = map[string]map[string]policy{
+	"/project": {
+		http.MethodPost: {authenticated: true},
+	},
+	"/project/{id}": {
+		http.MethodGet:   {public: true},
+		http.MethodPut:   {role: pb.ProjectRole_ADMIN},
+		http.MethodPatch: {role: pb.ProjectRole_ADMIN},
+	},
+	"/project/{id}/draw": {
+		http.MethodPost: {role: pb.ProjectRole_ADMIN},
+	},
+	"/project/{id}/draw/verify": {
+		http.MethodGet: {public: true},
+	},
+	"/project/{id}/winners": {
+		http.MethodGet: {public: true},
+	},
+	"/project/{id}/allowlist": {
+		http.MethodGet: {public: true},
+	},
+	"/project/{id}/allowlist/proof/{address}": {
+		http.MethodGet: {public: true},
+	},
+	"/project/{id}/entries": {
+		http.MethodGet: {role: pb.ProjectRole_VIEWER},
+	},
+	"/project/{id}/entries.csv": {
+		http.MethodGet: {role: pb.ProjectRole_VIEWER},
+	},
+	"/project/{id}/members": {
+		http.MethodGet: {role: pb.ProjectRole_VIEWER},
+	},
+	"/project/{id}/members/{userID}": {
+		http.MethodPut:    {role: pb.ProjectRole_OWNER},
+		http.MethodDelete: {role: pb.ProjectRole_OWNER},
+	},
+}
+
+// authorize is the middleware checking the authenticated user against the policy of the api. It responds
+// 401 Unauthorized if the user is not authenticated, and 403 Forbidden if the user does not have the role in the project
+// or the api has no policy
+func (h *handler) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		template := ""
+		if route := mux.CurrentRoute(req); route != nil {
+			template, _ = route.GetPathTemplate()
+		}
+		template = strings.TrimSuffix(template, "/")
+		p, ok := policies[template][req.Method]
+		if !ok {
+			h.log.Error(fmt.Errorf("no policy for the api"), "denying request", "method", req.Method, "path", template)
+			_ = utils.RespondError(w, http.StatusForbidden, "access to the api is not allowed")
+			return
+		}
+		if p.public {
+			next.ServeHTTP(w, req)
+			return
+		}
+		userID, ok := auth.UserID(req.Context())
+		if !ok {
+			_ = utils.RespondError(w, http.StatusUnauthorized, "authentication required")
+			return
+		}
+		if p.authenticated {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		// Only the apis with the least role get here, to resolve the role of the user
+		projectID, _ := strconv.ParseInt(mux.Vars(req)["id"], 10, 64)
+		ok, err := h.hasRole(req.Context(), projectID, userID, p.role)
+		if err != nil {
+			h.log.Error(err, "cannot get the role of the user", "project_id", projectID, "user_id", userID)
+			_ = utils.RespondGRPCError(w, err)
+			return
+		}
+		if !ok {
+			_ = utils.RespondError(w, http.StatusForbidden, fmt.Sprintf("%s role in the project is required", p.role))
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// authorized applies authorize to an api which is not under /project, i.e., the creation of a project
+func (h *handler) authorized(handler http.HandlerFunc) http.HandlerFunc {
+	return h.authorize(handler).ServeHTTP
+}
+
+// hasRole returns true if the user has the role, or a higher one, in the project. The members are listed only if the
+// user is not the owner and the role can be granted to the members, i.e., it is not OWNER
+func (h *handler) hasRole(ctx context.Context, projectID, userID int64, role pb.ProjectRole) (bool, error) {
+	projectSvcCli := pb.NewProjectServiceClient(h.projectSvcConn)
+	resp, err := projectSvcCli.GetProject(ctx, &pb.GetProjectRequest{ProjectID: projectID})
+	if err != nil {
+		return false, err
+	}
+	if resp.Project.OwnerID != 0 && resp.Project.OwnerID == userID {
+		return true, nil
+	}
+	if role == pb.ProjectRole_OWNER {
+		return false, nil
+	}
+	members, err := projectSvcCli.ListProjectMembers(ctx, &pb.ListProjectMembersRequest{ProjectID: projectID})
+	if err != nil {
+		return false, err
+	}
+	for _, m := range members.Members {
+		if m.UserID == userID {
+			return m.Role >= role, nil
+		}
+	}
+	return false, nil
+}
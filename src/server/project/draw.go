@@ -0,0 +1,280 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/chain"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/raffle"
+	"github.com/theraffle/frontservice/src/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// entriesPageSize is the page size of listing all the entries of a project
+const entriesPageSize = 500
+
+type drawProjectReqBody struct {
+	Seed        string `json:"seed"`
+	BlockNumber int64  `json:"block_number"`
+}
+
+// drawProjectHandler draws the winners of a closed project, with the seed revealed for its seed commitment and the
+// first block after its end time
+func (h *handler) drawProjectHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("draw_project_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	// Decode request body
+	drawProjectReq := &drawProjectReqBody{}
+	if err := utils.DecodeBody(req, drawProjectReq, &pb.DrawProjectRequest{}); err != nil {
+		h.log.Error(err, "draw project error")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
+		return
+	}
+	seed, err := raffle.DecodeHex(drawProjectReq.Seed)
+	if err != nil || len(seed) != raffle.SeedLength {
+		_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("seed must be %d bytes in 0x-prefixed hex", raffle.SeedLength))
+		return
+	}
+	if drawProjectReq.BlockNumber <= 0 {
+		_ = utils.RespondError(w, http.StatusBadRequest, "block_number must be a positive integer")
+		return
+	}
+
+	log.Info("drawing project", "id", id)
+
+	intID, _ := strconv.Atoi(id)
+	projectID := int64(intID)
+	// Serialize the draw with the updates of the project
+	unlock := h.projectLocks.Lock(id)
+	defer unlock()
+
+	projectSvcCli := pb.NewProjectServiceClient(h.projectSvcConn)
+	current, err := projectSvcCli.GetProject(h.ctx, &pb.GetProjectRequest{ProjectID: projectID})
+	if err != nil {
+		h.log.Error(err, "draw project error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	now := time.Now()
+	project := current.Project
+	raffle.ApplyEffectiveStatus(project, now)
+
+	// A draw stored without marking the project drawn (e.g., by a failure) is completed, instead of drawn again
+	if _, err := projectSvcCli.GetProjectDraw(h.ctx, &pb.GetProjectDrawRequest{ProjectID: projectID}); err == nil {
+		if project.Status == pb.ProjectStatus_CLOSED {
+			if err := h.markDrawn(projectSvcCli, projectID); err != nil {
+				h.log.Error(err, "draw project error")
+				_ = utils.RespondGRPCError(w, err)
+				return
+			}
+		}
+		_ = utils.RespondError(w, http.StatusConflict, "project has already been drawn")
+		return
+	} else if status.Code(err) != codes.NotFound {
+		h.log.Error(err, "draw project error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+
+	if project.Status != pb.ProjectStatus_CLOSED {
+		_ = utils.RespondError(w, http.StatusConflict, fmt.Sprintf("project must be CLOSED to be drawn, but is %s", project.Status))
+		return
+	}
+	if project.SeedCommitment == "" {
+		_ = utils.RespondError(w, http.StatusConflict, "project has no seed_commitment")
+		return
+	}
+	if !strings.EqualFold(raffle.Commitment(seed), project.SeedCommitment) {
+		_ = utils.RespondError(w, http.StatusBadRequest, "seed does not match seed_commitment of the project")
+		return
+	}
+
+	block, parent, err := h.drawBlock(project.ChainID, drawProjectReq.BlockNumber)
+	if err != nil {
+		h.log.Error(err, "draw project error")
+		respondChainError(w, project.ChainID, err)
+		return
+	}
+	if block == nil {
+		_ = utils.RespondError(w, http.StatusConflict, fmt.Sprintf("block %d has not been produced yet", drawProjectReq.BlockNumber))
+		return
+	}
+	if err := raffle.CheckDrawBlock(project, block, parent); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entries, err := h.listAllEntries(h.ctx, projectID)
+	if err != nil {
+		h.log.Error(err, "draw project error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	proof, winners := raffle.Draw(seed, project.SeedCommitment, block, entries, int(project.MaxWinners))
+	draw, err := projectSvcCli.CreateProjectDraw(h.ctx, &pb.CreateProjectDrawRequest{Draw: &pb.ProjectDraw{
+		ProjectID: projectID,
+		Seed:      raffle.EncodeHex(seed),
+		Proof:     proof,
+		Winners:   winners,
+		DrawnAt:   timestamppb.New(now),
+	}})
+	if err != nil {
+		h.log.Error(err, "draw project error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	if err := h.markDrawn(projectSvcCli, projectID); err != nil {
+		h.log.Error(err, "draw project error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	log.Info("project drawn", "id", id, "entries", proof.EntryCount, "winners", len(winners))
+	_ = utils.Respond(w, req, draw)
+}
+
+// markDrawn moves the project to DRAWN
+func (h *handler) markDrawn(projectSvcCli pb.ProjectServiceClient, projectID int64) error {
+	_, err := projectSvcCli.UpdateProject(h.ctx, &pb.UpdateProjectRequest{
+		ProjectID:  projectID,
+		Project:    &pb.Project{ProjectID: projectID, Status: pb.ProjectStatus_DRAWN},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+	})
+	return err
+}
+
+// drawBlock gets the block of the number and its parent from the chain. The block is nil if it has not been produced,
+// and the parent is nil for the genesis block
+func (h *handler) drawBlock(chainID, number int64) (*chain.Block, *chain.Block, error) {
+	block, err := h.chains.BlockByNumber(h.ctx, chainID, uint64(number))
+	if err != nil || block == nil || number == 0 {
+		return block, nil, err
+	}
+	parent, err := h.chains.BlockByNumber(h.ctx, chainID, uint64(number-1))
+	if err != nil {
+		return nil, nil, err
+	}
+	return block, parent, nil
+}
+
+// respondChainError responds 503 Service Unavailable if the chain has no endpoint, and 502 Bad Gateway otherwise
+func respondChainError(w http.ResponseWriter, chainID int64, err error) {
+	if errors.Is(err, chain.ErrNoEndpoint) {
+		_ = utils.RespondError(w, http.StatusServiceUnavailable, fmt.Sprintf("no JSON-RPC endpoint of chain %d is configured", chainID))
+		return
+	}
+	_ = utils.RespondError(w, http.StatusBadGateway, fmt.Sprintf("cannot get the block from chain %d", chainID))
+}
+
+// listAllEntries lists all the entries of the project, page by page
+func (h *handler) listAllEntries(ctx context.Context, projectID int64) ([]*pb.ProjectEntry, error) {
+	userSvcCli := pb.NewUserServiceClient(h.userSvcConn)
+	var entries []*pb.ProjectEntry
+	pageToken := ""
+	for {
+		resp, err := userSvcCli.ListProjectEntries(ctx, &pb.ListProjectEntriesRequest{
+			ProjectID: projectID,
+			PageSize:  entriesPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, resp.Entries...)
+		if resp.NextPageToken == "" {
+			return entries, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// getProjectWinnersHandler responds the draw of the project, i.e., the winners with the seed and the proof
+func (h *handler) getProjectWinnersHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("get_project_winners_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	log.Info("getting project winners", "id", id)
+	intID, _ := strconv.Atoi(id)
+	resp, err := pb.NewProjectServiceClient(h.projectSvcConn).GetProjectDraw(h.ctx, &pb.GetProjectDrawRequest{ProjectID: int64(intID)})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, resp)
+}
+
+// verifyProjectDrawHandler recomputes the draw of the project from its seed, the block on the chain and the current
+// entries
+func (h *handler) verifyProjectDrawHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("verify_project_draw_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	log.Info("verifying project draw", "id", id)
+	intID, _ := strconv.Atoi(id)
+	projectID := int64(intID)
+	projectSvcCli := pb.NewProjectServiceClient(h.projectSvcConn)
+	draw, err := projectSvcCli.GetProjectDraw(h.ctx, &pb.GetProjectDrawRequest{ProjectID: projectID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	project, err := projectSvcCli.GetProject(h.ctx, &pb.GetProjectRequest{ProjectID: projectID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	block, parent, err := h.drawBlock(project.Project.ChainID, draw.GetProof().GetBlockNumber())
+	if err != nil {
+		h.log.Error(err, "")
+		respondChainError(w, project.Project.ChainID, err)
+		return
+	}
+	entries, err := h.listAllEntries(h.ctx, projectID)
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, raffle.Verify(draw, project.Project, block, parent, entries))
+}
@@ -0,0 +1,118 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package project
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// csvHeader is the header row of the exported entries
+var csvHeader = []string{"user_id", "chain_id", "address"}
+
+// listProjectEntriesHandler lists the entries of the project page by page, with the query parameters page_size and page_token
+func (h *handler) listProjectEntriesHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("list_project_entries_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	pageSize, err := parsePageSize(req.URL.Query())
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	intID, _ := strconv.Atoi(id)
+
+	log.Info("listing project entries", "id", id, "page_size", pageSize)
+
+	resp, err := pb.NewUserServiceClient(h.userSvcConn).ListProjectEntries(h.ctx, &pb.ListProjectEntriesRequest{
+		ProjectID: int64(intID),
+		PageSize:  pageSize,
+		PageToken: req.URL.Query().Get("page_token"),
+	})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, resp)
+}
+
+// exportProjectEntriesHandler streams all the entries of the project as CSV, page by page
+func (h *handler) exportProjectEntriesHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("export_project_entries_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	intID, _ := strconv.Atoi(id)
+	projectID := int64(intID)
+
+	log.Info("exporting project entries", "id", id)
+
+	userSvcCli := pb.NewUserServiceClient(h.userSvcConn)
+	listReq := &pb.ListProjectEntriesRequest{ProjectID: projectID, PageSize: entriesPageSize}
+	// The first page is fetched before the response is started, so that its error can be responded
+	resp, err := userSvcCli.ListProjectEntries(req.Context(), listReq)
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="project-%d-entries.csv"`, projectID))
+	w.WriteHeader(http.StatusOK)
+	writer := csv.NewWriter(w)
+	_ = writer.Write(csvHeader)
+	count := 0
+	for {
+		for _, e := range resp.Entries {
+			_ = writer.Write([]string{strconv.FormatInt(e.UserID, 10), strconv.FormatInt(e.ChainID, 10), e.Address})
+		}
+		count += len(resp.Entries)
+		writer.Flush()
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+		if writer.Error() != nil || resp.NextPageToken == "" {
+			break
+		}
+		listReq.PageToken = resp.NextPageToken
+		if resp, err = userSvcCli.ListProjectEntries(req.Context(), listReq); err != nil {
+			// The response has been started, so the export is truncated
+			log.Error(err, "cannot export all the entries", "exported", count)
+			return
+		}
+	}
+	if err := writer.Error(); err != nil {
+		log.Error(err, "cannot write the entries", "exported", count)
+		return
+	}
+	log.Info("exported project entries", "id", id, "entries", count)
+}
@@ -0,0 +1,184 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/theraffle/frontservice/src/genproto/pb"
+)
+
+// Sort orders of the projects
+const (
+	sortByID       = "id"
+	sortByIDDesc   = "-id"
+	sortByName     = "name"
+	sortByNameDesc = "-name"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var errInvalidPageToken = fmt.Errorf("invalid page token")
+
+// listProjectsRequest builds a ListProjectsRequest from the query parameters chain_id, name, sort, page_size and page_token
+func listProjectsRequest(query url.Values) (*pb.ListProjectsRequest, error) {
+	listReq := &pb.ListProjectsRequest{
+		Name:      query.Get("name"),
+		Sort:      query.Get("sort"),
+		PageToken: query.Get("page_token"),
+	}
+	if v := query.Get("chain_id"); v != "" {
+		chainID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || chainID <= 0 {
+			return nil, fmt.Errorf("chain_id must be a positive integer")
+		}
+		listReq.ChainID = chainID
+	}
+	switch listReq.Sort {
+	case "":
+		listReq.Sort = sortByID
+	case sortByID, sortByIDDesc, sortByName, sortByNameDesc:
+	default:
+		return nil, fmt.Errorf("sort must be one of %s, %s, %s and %s", sortByID, sortByIDDesc, sortByName, sortByNameDesc)
+	}
+	pageSize, err := parsePageSize(query)
+	if err != nil {
+		return nil, err
+	}
+	listReq.PageSize = pageSize
+	return listReq, nil
+}
+
+// parsePageSize parses the query parameter page_size, which defaults to defaultPageSize
+func parsePageSize(query url.Values) (int32, error) {
+	v := query.Get("page_size")
+	if v == "" {
+		return defaultPageSize, nil
+	}
+	pageSize, err := strconv.Atoi(v)
+	if err != nil || pageSize <= 0 || pageSize > maxPageSize {
+		return 0, fmt.Errorf("page_size must be between 1 and %d", maxPageSize)
+	}
+	return int32(pageSize), nil
+}
+
+// pageToken is the cursor of the projects paged in the gateway, i.e., the sort key of the last project of the previous page.
+// Query is the filter and the sort order which the token was issued for
+type pageToken struct {
+	Query string `json:"q"`
+	ID    int64  `json:"i"`
+	Name  string `json:"n,omitempty"`
+}
+
+func queryOf(listReq *pb.ListProjectsRequest) string {
+	return fmt.Sprintf("%d/%s/%s", listReq.ChainID, listReq.Sort, listReq.Name)
+}
+
+func encodePageToken(listReq *pb.ListProjectsRequest, last *pb.Project) string {
+	token := pageToken{Query: queryOf(listReq), ID: last.ProjectID}
+	if listReq.Sort == sortByName || listReq.Sort == sortByNameDesc {
+		token.Name = last.ProjectName
+	}
+	j, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(j)
+}
+
+func decodePageToken(listReq *pb.ListProjectsRequest) (*pageToken, error) {
+	j, err := base64.RawURLEncoding.DecodeString(listReq.PageToken)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	token := &pageToken{}
+	if err := json.Unmarshal(j, token); err != nil || token.Query != queryOf(listReq) {
+		return nil, errInvalidPageToken
+	}
+	return token, nil
+}
+
+// less compares the projects in the sort order. Names are compared case-insensitively,
+// and projects with the same name are ordered by their ids
+func less(sortBy string, aID int64, aName string, bID int64, bName string) bool {
+	aName, bName = strings.ToLower(aName), strings.ToLower(bName)
+	switch sortBy {
+	case sortByIDDesc:
+		return aID > bID
+	case sortByName:
+		if aName != bName {
+			return aName < bName
+		}
+		return aID < bID
+	case sortByNameDesc:
+		if aName != bName {
+			return aName > bName
+		}
+		return aID > bID
+	default:
+		return aID < bID
+	}
+}
+
+// listProjectsInGateway pages the projects in the gateway, for the project services which do not implement ListProjects
+func (h *handler) listProjectsInGateway(ctx context.Context, listReq *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	var cursor *pageToken
+	if listReq.PageToken != "" {
+		token, err := decodePageToken(listReq)
+		if err != nil {
+			return nil, err
+		}
+		cursor = token
+	}
+
+	all, err := pb.NewProjectServiceClient(h.projectSvcConn).GetAllProjects(ctx, &pb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []*pb.Project
+	name := strings.ToLower(listReq.Name)
+	for _, p := range all.Projects {
+		if listReq.ChainID != 0 && p.ChainID != listReq.ChainID {
+			continue
+		}
+		if name != "" && !strings.Contains(strings.ToLower(p.ProjectName), name) {
+			continue
+		}
+		if cursor != nil && !less(listReq.Sort, cursor.ID, cursor.Name, p.ProjectID, p.ProjectName) {
+			continue
+		}
+		projects = append(projects, p)
+	}
+	sort.SliceStable(projects, func(i, j int) bool {
+		return less(listReq.Sort, projects[i].ProjectID, projects[i].ProjectName, projects[j].ProjectID, projects[j].ProjectName)
+	})
+
+	resp := &pb.ListProjectsResponse{Projects: projects}
+	if len(projects) > int(listReq.PageSize) {
+		resp.Projects = projects[:listReq.PageSize]
+		resp.NextPageToken = encodePageToken(listReq, resp.Projects[len(resp.Projects)-1])
+	}
+	return resp, nil
+}
@@ -0,0 +1,140 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package project
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// listProjectMembersHandler lists the members of the project, with its owner first
+func (h *handler) listProjectMembersHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("list_project_members_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	log.Info("listing project members", "id", id)
+	intID, _ := strconv.Atoi(id)
+	projectID := int64(intID)
+	projectSvcCli := pb.NewProjectServiceClient(h.projectSvcConn)
+	project, err := projectSvcCli.GetProject(h.ctx, &pb.GetProjectRequest{ProjectID: projectID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	members, err := projectSvcCli.ListProjectMembers(h.ctx, &pb.ListProjectMembersRequest{ProjectID: projectID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	resp := &pb.ListProjectMembersResponse{}
+	if project.Project.OwnerID != 0 {
+		resp.Members = append(resp.Members, &pb.ProjectMember{ProjectID: projectID, UserID: project.Project.OwnerID, Role: pb.ProjectRole_OWNER})
+	}
+	resp.Members = append(resp.Members, members.Members...)
+	_ = utils.Respond(w, req, resp)
+}
+
+// setProjectMemberHandler grants a role in the project to the user. Only ADMIN and VIEWER can be granted
+func (h *handler) setProjectMemberHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("set_project_member_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	userID, err := strconv.ParseInt(mux.Vars(req)["userID"], 10, 64)
+	if err != nil || userID <= 0 {
+		_ = utils.RespondError(w, http.StatusBadRequest, "user id must be a positive integer")
+		return
+	}
+	// Decode request body
+	member := &pb.ProjectMember{}
+	if err := utils.DecodeMessage(req, member); err != nil {
+		h.log.Error(err, "set project member error")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
+		return
+	}
+	if member.Role != pb.ProjectRole_ADMIN && member.Role != pb.ProjectRole_VIEWER {
+		_ = utils.RespondError(w, http.StatusBadRequest, "role must be ADMIN or VIEWER")
+		return
+	}
+	intID, _ := strconv.Atoi(id)
+	member.ProjectID, member.UserID = int64(intID), userID
+
+	projectSvcCli := pb.NewProjectServiceClient(h.projectSvcConn)
+	project, err := projectSvcCli.GetProject(h.ctx, &pb.GetProjectRequest{ProjectID: member.ProjectID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	if project.Project.OwnerID == userID {
+		_ = utils.RespondError(w, http.StatusBadRequest, "owner of the project cannot be granted a role")
+		return
+	}
+
+	log.Info("granting project role", "id", id, "user_id", userID, "role", member.Role)
+
+	resp, err := projectSvcCli.SetProjectMember(h.ctx, member)
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, resp)
+}
+
+// deleteProjectMemberHandler revokes the role of the user in the project
+func (h *handler) deleteProjectMemberHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("delete_project_member_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	userID, err := strconv.ParseInt(mux.Vars(req)["userID"], 10, 64)
+	if err != nil || userID <= 0 {
+		_ = utils.RespondError(w, http.StatusBadRequest, "user id must be a positive integer")
+		return
+	}
+
+	log.Info("revoking project role", "id", id, "user_id", userID)
+
+	intID, _ := strconv.Atoi(id)
+	resp, err := pb.NewProjectServiceClient(h.projectSvcConn).DeleteProjectMember(h.ctx, &pb.DeleteProjectMemberRequest{
+		ProjectID: int64(intID),
+		UserID:    userID,
+	})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, resp)
+}
@@ -18,34 +18,52 @@ package project
 
 import (
 	"context"
-	"encoding/json"
 	"github.com/go-logr/logr"
 	"github.com/gorilla/mux"
 	"github.com/theraffle/frontservice/src/apihandler"
+	"github.com/theraffle/frontservice/src/auth"
+	"github.com/theraffle/frontservice/src/chain"
 	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/raffle"
 	"github.com/theraffle/frontservice/src/utils"
 	"github.com/theraffle/frontservice/src/wrapper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
+	"os"
 	"strconv"
+	"time"
 )
 
 type handler struct {
 	ctx context.Context
 	log logr.Logger
 
-	projectSvcAddr string
+	userSvcConn    *grpc.ClientConn
 	projectSvcConn *grpc.ClientConn
+
+	chainIDs     utils.ChainIDs
+	chains       *chain.Client
+	projectLocks utils.KeyedMutex
 }
 
 // NewHandler instantiates a new apis handler
-func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.Logger) (apihandler.APIHandler, error) {
-	handler := &handler{ctx: ctx, log: logger}
-	utils.MustMapEnv(&handler.projectSvcAddr, "PROJECT_SERVICE_ADDR")
-	utils.MustConnGRPC(ctx, &handler.projectSvcConn, handler.projectSvcAddr)
+func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.Logger, userSvcConn, projectSvcConn *grpc.ClientConn) (apihandler.APIHandler, error) {
+	handler := &handler{ctx: ctx, log: logger, userSvcConn: userSvcConn, projectSvcConn: projectSvcConn}
+	chainIDs, err := utils.ParseChainIDs(os.Getenv("SUPPORTED_CHAIN_IDS"))
+	if err != nil {
+		return nil, err
+	}
+	handler.chainIDs = chainIDs
+	endpoints, err := chain.ParseEndpoints(os.Getenv("CHAIN_RPC_URLS"))
+	if err != nil {
+		return nil, err
+	}
+	handler.chains = chain.NewClient(endpoints)
 
 	// Create Project
-	createProject := wrapper.New("/project", []string{http.MethodPost}, handler.createProjectHandler)
+	createProject := wrapper.New("/project", []string{http.MethodPost}, handler.authorized(handler.createProjectHandler))
 	if err := parent.Add(createProject); err != nil {
 		return nil, err
 	}
@@ -56,55 +74,147 @@ func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.L
 		return nil, err
 	}
 
+	// The apis under /project are authorized by the roles of the users in the projects
+	projectRootWrapper := wrapper.New("/project", nil, nil)
+	if err := parent.Add(projectRootWrapper); err != nil {
+		return nil, err
+	}
+	projectRootWrapper.Router().Use(handler.authorize)
+
 	// Get Certain Project
-	getProject := wrapper.New("/project/{id}", []string{http.MethodGet}, handler.getProjectHandler)
-	if err := parent.Add(getProject); err != nil {
+	getProject := wrapper.New("/{id}", []string{http.MethodGet}, handler.getProjectHandler)
+	if err := projectRootWrapper.Add(getProject); err != nil {
 		return nil, err
 	}
 	// Edit Project
-	updateProject := wrapper.New("/project/{id}", []string{http.MethodPut}, handler.updateProjectHandler)
-	if err := parent.Add(updateProject); err != nil {
+	updateProject := wrapper.New("/{id}", []string{http.MethodPut}, handler.updateProjectHandler)
+	if err := projectRootWrapper.Add(updateProject); err != nil {
 		return nil, err
 	}
 
-	return handler, nil
-}
+	// Patch Project
+	patchProject := wrapper.New("/{id}", []string{http.MethodPatch}, handler.patchProjectHandler)
+	if err := projectRootWrapper.Add(patchProject); err != nil {
+		return nil, err
+	}
+
+	projectWrapper := wrapper.New("/{id}", nil, nil)
+	if err := projectRootWrapper.Add(projectWrapper); err != nil {
+		return nil, err
+	}
+
+	// List Project Members
+	listProjectMembers := wrapper.New("/members", []string{http.MethodGet}, handler.listProjectMembersHandler)
+	if err := projectWrapper.Add(listProjectMembers); err != nil {
+		return nil, err
+	}
 
-type createProjectReqBody struct {
-	ProjectName    string `json:"project_name,omitempty"`
-	ChainID        int64  `json:"chain_id,omitempty"`
-	RaffleContract string `json:"raffle_contract,omitempty"`
+	// Grant Project Role
+	setProjectMember := wrapper.New("/{userID}", []string{http.MethodPut}, handler.setProjectMemberHandler)
+	if err := listProjectMembers.Add(setProjectMember); err != nil {
+		return nil, err
+	}
+
+	// Revoke Project Role
+	deleteProjectMember := wrapper.New("/{userID}", []string{http.MethodDelete}, handler.deleteProjectMemberHandler)
+	if err := listProjectMembers.Add(deleteProjectMember); err != nil {
+		return nil, err
+	}
+
+	// Export Project Entries, which is added before /entries not to be matched by its path prefix
+	exportProjectEntries := wrapper.New("/entries.csv", []string{http.MethodGet}, handler.exportProjectEntriesHandler)
+	if err := projectWrapper.Add(exportProjectEntries); err != nil {
+		return nil, err
+	}
+
+	// List Project Entries
+	listProjectEntries := wrapper.New("/entries", []string{http.MethodGet}, handler.listProjectEntriesHandler)
+	if err := projectWrapper.Add(listProjectEntries); err != nil {
+		return nil, err
+	}
+
+	// Draw Project Winners
+	drawProject := wrapper.New("/draw", []string{http.MethodPost}, handler.drawProjectHandler)
+	if err := projectWrapper.Add(drawProject); err != nil {
+		return nil, err
+	}
+
+	// Get Project Winners
+	getProjectWinners := wrapper.New("/winners", []string{http.MethodGet}, handler.getProjectWinnersHandler)
+	if err := projectWrapper.Add(getProjectWinners); err != nil {
+		return nil, err
+	}
+
+	// Verify Project Draw
+	verifyProjectDraw := wrapper.New("/verify", []string{http.MethodGet}, handler.verifyProjectDrawHandler)
+	if err := drawProject.Add(verifyProjectDraw); err != nil {
+		return nil, err
+	}
+
+	// Get Project Allowlist
+	getAllowlist := wrapper.New("/allowlist", []string{http.MethodGet}, handler.getAllowlistHandler)
+	if err := projectWrapper.Add(getAllowlist); err != nil {
+		return nil, err
+	}
+
+	// Get Project Allowlist Proof
+	getAllowlistProof := wrapper.New("/proof/{address}", []string{http.MethodGet}, handler.getAllowlistProofHandler)
+	if err := getAllowlist.Add(getAllowlistProof); err != nil {
+		return nil, err
+	}
+
+	return handler, nil
 }
 
 func (h *handler) createProjectHandler(w http.ResponseWriter, req *http.Request) {
-	reqID := utils.RandomString(10)
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("create_project_request", reqID)
 
 	log.Info("create project request")
 	// Decode request body
-	createProjectReq := &createProjectReqBody{}
-	decoder := json.NewDecoder(req.Body)
-	if err := decoder.Decode(createProjectReq); err != nil {
+	createProjectReq := &pb.CreateProjectRequest{}
+	if err := utils.DecodeMessage(req, createProjectReq); err != nil {
 		h.log.Error(err, "create project error")
-		_ = utils.RespondError(w, http.StatusBadRequest, "request body is not in json form or is malformed")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
 		return
 	}
-	// TODO request validity check
-
-	resp, err := pb.NewProjectServiceClient(h.projectSvcConn).CreateProject(h.ctx, &pb.CreateProjectRequest{
+	project := &pb.Project{
 		ProjectName:    createProjectReq.ProjectName,
 		ChainID:        createProjectReq.ChainID,
 		RaffleContract: createProjectReq.RaffleContract,
-	})
+		Status:         createProjectReq.Status,
+		StartTime:      createProjectReq.StartTime,
+		EndTime:        createProjectReq.EndTime,
+		MaxWinners:     createProjectReq.MaxWinners,
+		SeedCommitment: createProjectReq.SeedCommitment,
+		Eligibility:    createProjectReq.Eligibility,
+	}
+	// The owner is the authenticated user, not the one in the body
+	createProjectReq.OwnerID, _ = auth.UserID(req.Context())
+	if err := h.validateProject(project, projectFields); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := validateInitialStatus(project.Status); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := raffle.ValidateSchedule(project, time.Now()); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := pb.NewProjectServiceClient(h.projectSvcConn).CreateProject(h.ctx, createProjectReq)
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		_ = utils.RespondGRPCError(w, err)
+		return
 	}
-	_ = utils.RespondJSON(w, resp)
+	_ = utils.Respond(w, req, resp)
 }
 
 func (h *handler) getProjectHandler(w http.ResponseWriter, req *http.Request) {
-	reqID := utils.RandomString(10)
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("get_project_request", reqID)
 	id := mux.Vars(req)["id"]
 	if id == "" {
@@ -116,53 +226,44 @@ func (h *handler) getProjectHandler(w http.ResponseWriter, req *http.Request) {
 	resp, err := pb.NewProjectServiceClient(h.projectSvcConn).GetProject(h.ctx, &pb.GetProjectRequest{ProjectID: int64(intID)})
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	raffle.ApplyEffectiveStatus(resp.Project, time.Now())
+	if utils.CheckNotModified(w, req, utils.ETag(resp.Project)) {
+		return
 	}
-	_ = utils.RespondJSON(w, resp)
+	_ = utils.Respond(w, req, resp)
 }
 
-func (h *handler) getAllProjectHandler(w http.ResponseWriter, _ *http.Request) {
-	reqID := utils.RandomString(10)
+func (h *handler) getAllProjectHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("get_all_project_request", reqID)
 
-	log.Info("getting all projects list")
-
-	resp, err := pb.NewProjectServiceClient(h.projectSvcConn).GetAllProjects(h.ctx, &pb.Empty{})
+	listReq, err := listProjectsRequest(req.URL.Query())
 	if err != nil {
-		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
 	}
-	_ = utils.RespondJSON(w, resp)
-}
+	log.Info("listing projects", "chain_id", listReq.ChainID, "name", listReq.Name, "sort", listReq.Sort, "page_size", listReq.PageSize)
 
-type updateProjectReqBody struct {
-}
-
-func (h *handler) updateProjectHandler(w http.ResponseWriter, req *http.Request) {
-	// TODO: modify when project components are decided
-	reqID := utils.RandomString(10)
-	log := h.log.WithValues("update_project_request", reqID)
-	id := mux.Vars(req)["id"]
-	if id == "" {
-		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
-		return
+	resp, err := pb.NewProjectServiceClient(h.projectSvcConn).ListProjects(h.ctx, listReq)
+	if status.Code(err) == codes.Unimplemented {
+		log.Info("project service does not implement ListProjects, paging in the gateway")
+		resp, err = h.listProjectsInGateway(h.ctx, listReq)
 	}
-	// Decode request body
-	updateProjectReq := &updateProjectReqBody{}
-	decoder := json.NewDecoder(req.Body)
-	if err := decoder.Decode(updateProjectReq); err != nil {
-		h.log.Error(err, "update project error")
-		_ = utils.RespondError(w, http.StatusBadRequest, "request body is not in json form or is malformed")
+	if err == errInvalidPageToken {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	log.Info("updating project info", "id", id)
-
-	intID, _ := strconv.Atoi(id)
-	resp, err := pb.NewProjectServiceClient(h.projectSvcConn).UpdateProject(h.ctx, &pb.UpdateProjectRequest{ProjectID: int64(intID)})
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "update user error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	now := time.Now()
+	for _, project := range resp.Projects {
+		raffle.ApplyEffectiveStatus(project, now)
 	}
-	_ = utils.RespondJSON(w, resp)
+	_ = utils.Respond(w, req, resp)
 }
@@ -0,0 +1,285 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package project
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/raffle"
+	"github.com/theraffle/frontservice/src/utils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const maxProjectNameLength = 100
+
+// projectFields are the updatable fields of a project, i.e., all the fields but projectID and ownerID
+var projectFields = []string{"projectName", "chainID", "raffleContract", "status", "startTime", "endTime", "maxWinners", "seedCommitment", "eligibility"}
+
+// validateProject checks the fields of the project. Only the fields in paths, or whose nested fields are in paths,
+// are checked
+func (h *handler) validateProject(project *pb.Project, paths []string) error {
+	for _, path := range paths {
+		switch strings.SplitN(path, ".", 2)[0] {
+		case "projectName":
+			if project.ProjectName == "" {
+				return fmt.Errorf("project_name is required")
+			}
+			if utf8.RuneCountInString(project.ProjectName) > maxProjectNameLength {
+				return fmt.Errorf("project_name must be at most %d characters", maxProjectNameLength)
+			}
+		case "chainID":
+			if err := h.chainIDs.ValidateChainID(project.ChainID); err != nil {
+				return fmt.Errorf("invalid chain_id: %v", err)
+			}
+		case "raffleContract":
+			// Raffle contracts may not be deployed yet
+			if project.RaffleContract == "" {
+				continue
+			}
+			if err := utils.ValidateAddress(project.RaffleContract); err != nil {
+				return fmt.Errorf("invalid raffle_contract: %v", err)
+			}
+		case "maxWinners":
+			if project.MaxWinners < 0 {
+				return fmt.Errorf("max_winners must not be negative")
+			}
+		case "seedCommitment":
+			if project.SeedCommitment == "" {
+				continue
+			}
+			if err := raffle.ValidateCommitment(project.SeedCommitment); err != nil {
+				return fmt.Errorf("invalid seed_commitment: %v", err)
+			}
+		case "eligibility":
+			if err := raffle.ValidateEligibility(project.Eligibility); err != nil {
+				return fmt.Errorf("invalid eligibility: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateInitialStatus checks if a project may be created with the status
+func validateInitialStatus(status pb.ProjectStatus) error {
+	for _, s := range raffle.InitialStatuses {
+		if s == status {
+			return nil
+		}
+	}
+	return fmt.Errorf("project cannot be created as %s", status)
+}
+
+// mergeProject returns the current project with the fields in paths replaced by the ones of the update.
+// Paths of nested fields (e.g., eligibility.requireWallet) replace only the nested fields
+func mergeProject(current, update *pb.Project, paths []string) *pb.Project {
+	merged := proto.Clone(current).(*pb.Project)
+	for _, path := range paths {
+		dst, src := merged.ProtoReflect(), update.ProtoReflect()
+		names := strings.Split(path, ".")
+		for _, name := range names[:len(names)-1] {
+			fd := src.Descriptor().Fields().ByName(protoreflect.Name(name))
+			dst, src = dst.Mutable(fd).Message(), src.Get(fd).Message()
+		}
+		fd := src.Descriptor().Fields().ByName(protoreflect.Name(names[len(names)-1]))
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+	}
+	return merged
+}
+
+// updateProjectHandler replaces all the fields of the project
+func (h *handler) updateProjectHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("update_project_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	// Decode request body
+	project := &pb.Project{}
+	if err := utils.DecodeMessage(req, project); err != nil {
+		h.log.Error(err, "update project error")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
+		return
+	}
+	if err := h.validateProject(project, projectFields); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	log.Info("updating project info", "id", id)
+
+	intID, _ := strconv.Atoi(id)
+	h.updateProject(w, req, int64(intID), project, projectFields)
+}
+
+// patchProjectHandler updates the fields of the project in the body, by JSON merge patch (RFC 7396).
+// Fields set to null are reset, and nested objects (e.g., eligibility) are merged recursively
+func (h *handler) patchProjectHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("patch_project_request", reqID)
+	id := mux.Vars(req)["id"]
+	if id == "" {
+		_ = utils.RespondError(w, http.StatusBadRequest, "project id not specified")
+		return
+	}
+	if mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type")); mediaType != utils.ContentTypeMergePatch && mediaType != utils.ContentTypeJSON {
+		_ = utils.RespondError(w, http.StatusUnsupportedMediaType, fmt.Sprintf("content type must be %s", utils.ContentTypeMergePatch))
+		return
+	}
+
+	// Decode request body
+	body, err := utils.ReadBody(req)
+	if err != nil {
+		h.log.Error(err, "patch project error")
+		_ = utils.RespondDecodeError(w, err, "cannot read request body")
+		return
+	}
+	patch := map[string]json.RawMessage{}
+	project := &pb.Project{}
+	if err := json.Unmarshal(body, &patch); err != nil || utils.UnmarshalProtoJSON(body, project) != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, "request body is not a json merge patch of a project")
+		return
+	}
+	paths, err := patchPaths(patch)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := h.validateProject(project, paths); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	log.Info("patching project info", "id", id, "fields", paths)
+
+	intID, _ := strconv.Atoi(id)
+	h.updateProject(w, req, int64(intID), project, paths)
+}
+
+// patchPaths returns the field paths of the merge patch
+func patchPaths(patch map[string]json.RawMessage) ([]string, error) {
+	paths, err := messagePatchPaths((&pb.Project{}).ProtoReflect().Descriptor(), patch, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("patch has no fields")
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// messagePatchPaths returns the field paths of the merge patch of the message, prefixed by prefix.
+// Objects of message fields are merged, and yield the paths of their fields
+func messagePatchPaths(md protoreflect.MessageDescriptor, patch map[string]json.RawMessage, prefix string) ([]string, error) {
+	var paths []string
+	for key, value := range patch {
+		fd := utils.FieldByName(md, key)
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %s%s", prefix, key)
+		}
+		path := prefix + string(fd.Name())
+		if path == "projectID" || path == "ownerID" {
+			return nil, fmt.Errorf("%s cannot be updated", utils.ToSnakeCase(path))
+		}
+		nested := map[string]json.RawMessage{}
+		if !mergesFields(fd) || json.Unmarshal(value, &nested) != nil || nested == nil {
+			paths = append(paths, path)
+			continue
+		}
+		nestedPaths, err := messagePatchPaths(fd.Message(), nested, path+".")
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, nestedPaths...)
+	}
+	return paths, nil
+}
+
+// mergesFields checks if the patch of the field is merged into its fields, i.e., the field is a singular message
+// which is an object in JSON. Well-known types (e.g., timestamps, durations) are replaced as a whole
+func mergesFields(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind && fd.Cardinality() != protoreflect.Repeated &&
+		!strings.HasPrefix(string(fd.Message().FullName()), "google.protobuf.")
+}
+
+// updateProject updates the project if the If-Match header matches its current state.
+// The updated project is checked against the lifecycle of the projects, from its effective status
+func (h *handler) updateProject(w http.ResponseWriter, req *http.Request, projectID int64, project *pb.Project, paths []string) {
+	// Serialize the read-modify-write of the project, in addition to the If-Match check,
+	// within this replica only, since the service does not take the expected version (see docs/api.md)
+	unlock := h.projectLocks.Lock(strconv.FormatInt(projectID, 10))
+	defer unlock()
+
+	projectSvcCli := pb.NewProjectServiceClient(h.projectSvcConn)
+	current, err := projectSvcCli.GetProject(h.ctx, &pb.GetProjectRequest{ProjectID: projectID})
+	if err != nil {
+		h.log.Error(err, "update project error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	now := time.Now()
+	raffle.ApplyEffectiveStatus(current.Project, now)
+	if !utils.CheckPrecondition(w, req, utils.ETag(current.Project)) {
+		return
+	}
+	merged := mergeProject(current.Project, project, paths)
+	if err := raffle.ValidateTransition(current.Project.Status, merged.Status); err != nil {
+		_ = utils.RespondError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if err := raffle.ValidateFrozenFields(current.Project, merged); err != nil {
+		_ = utils.RespondError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if err := raffle.ValidateSchedule(merged, now); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	project.ProjectID = projectID
+	resp, err := projectSvcCli.UpdateProject(h.ctx, &pb.UpdateProjectRequest{
+		ProjectID:  projectID,
+		Project:    project,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+	})
+	if err != nil {
+		h.log.Error(err, "update project error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	raffle.ApplyEffectiveStatus(resp.Project, time.Now())
+	w.Header().Set("ETag", utils.ETag(resp.Project))
+	_ = utils.Respond(w, req, resp)
+}
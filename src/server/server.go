@@ -21,10 +21,19 @@ import (
 	"fmt"
 	"github.com/gorilla/mux"
 	"github.com/theraffle/frontservice/src/apihandler"
+	"github.com/theraffle/frontservice/src/auth"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/logging"
+	"github.com/theraffle/frontservice/src/middleware"
+	"github.com/theraffle/frontservice/src/server/admin"
+	"github.com/theraffle/frontservice/src/server/batch"
 	"github.com/theraffle/frontservice/src/server/project"
+	"github.com/theraffle/frontservice/src/server/transcoder"
 	"github.com/theraffle/frontservice/src/server/user"
 	"github.com/theraffle/frontservice/src/utils"
 	"github.com/theraffle/frontservice/src/wrapper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"net/http"
 	"os"
@@ -42,43 +51,138 @@ var (
 
 type frontendServer struct {
 	wrapper        wrapper.RouterWrapper
+	handler        http.Handler
 	userHandler    apihandler.APIHandler
 	projectHandler apihandler.APIHandler
+	adminHandler   apihandler.APIHandler
+	batchHandler   apihandler.APIHandler
+	transcoder     apihandler.APIHandler
+
+	userSvcConn    *grpc.ClientConn
+	projectSvcConn *grpc.ClientConn
+
+	maxHeaderBytes int
 }
 
-// New returns new frontend http server
-func New(ctx context.Context) (Server, error) {
+// New returns new frontend http server. Log levels are exposed to the admin apis
+func New(ctx context.Context, levels *logging.Levels) (Server, error) {
 	server := new(frontendServer)
 	server.wrapper = wrapper.New("/", nil, server.rootHandler)
 
 	server.wrapper.SetRouter(mux.NewRouter())
 	server.wrapper.Router().HandleFunc("/", server.rootHandler)
 
+	// Connect to the services
+	var userSvcAddr, projectSvcAddr string
+	utils.MustMapEnv(&userSvcAddr, "USER_SERVICE_ADDR")
+	utils.MustConnGRPC(ctx, &server.userSvcConn, userSvcAddr)
+	utils.MustMapEnv(&projectSvcAddr, "PROJECT_SERVICE_ADDR")
+	utils.MustConnGRPC(ctx, &server.projectSvcConn, projectSvcAddr)
+
 	// Set apisHandler
-	userHandler, err := user.NewHandler(ctx, server.wrapper, log)
+	userHandler, err := user.NewHandler(ctx, server.wrapper, log, server.userSvcConn, server.projectSvcConn)
 	if err != nil {
 		return nil, err
 	}
 	server.userHandler = userHandler
 
-	projectHandler, err := project.NewHandler(ctx, server.wrapper, log)
+	projectHandler, err := project.NewHandler(ctx, server.wrapper, log, server.userSvcConn, server.projectSvcConn)
 	if err != nil {
 		return nil, err
 	}
 	server.projectHandler = projectHandler
 
+	adminHandler, err := admin.NewHandler(ctx, server.wrapper, log, levels, server.projectSvcConn)
+	if err != nil {
+		return nil, err
+	}
+	server.adminHandler = adminHandler
+
+	batchHandler, err := batch.NewHandler(ctx, server.wrapper, log, server)
+	if err != nil {
+		return nil, err
+	}
+	server.batchHandler = batchHandler
+
+	// Expose the rest of the annotated rpc methods, which have no hand-written handlers
+	transcoderHandler, err := transcoder.NewHandler(ctx, server.wrapper, log.WithName("transcoder"), pb.File_pb_raffle_proto,
+		map[protoreflect.FullName]*grpc.ClientConn{
+			"pb.UserService":    server.userSvcConn,
+			"pb.ProjectService": server.projectSvcConn,
+		})
+	if err != nil {
+		return nil, err
+	}
+	server.transcoder = transcoderHandler
+
+	// Set middlewares
+	proxies, err := utils.ParseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		return nil, err
+	}
+	userHeader := auth.DefaultUserHeader
+	utils.MapEnv(&userHeader, "AUTH_USER_HEADER")
+	accessLogFormat := string(middleware.AccessLogJSON)
+	utils.MapEnv(&accessLogFormat, "ACCESS_LOG_FORMAT")
+	format, err := middleware.ParseAccessLogFormat(accessLogFormat)
+	if err != nil {
+		return nil, err
+	}
+	corsOpts, err := middleware.CORSOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	jsonOpts, err := utils.JSONOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	utils.SetJSONOptions(jsonOpts)
+	compressionOpts, err := middleware.CompressionOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	securityOpts, err := middleware.SecurityOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	server.maxHeaderBytes = securityOpts.MaxHeaderBytes
+	rateLimitRules, err := middleware.ParseRateLimitRules(os.Getenv("RATE_LIMITS"))
+	if err != nil {
+		return nil, err
+	}
+	rateLimitRules = append(rateLimitRules, middleware.DefaultRateLimitRules...)
+	server.handler = middleware.Chain(server.wrapper.Router(),
+		middleware.RequestID,
+		auth.Middleware(proxies, userHeader),
+		middleware.AccessLog(log.WithName("access"), format, server.wrapper.Router(), proxies),
+		middleware.Compress(compressionOpts),
+		middleware.CORS(corsOpts, server.wrapper.Router()),
+		middleware.Security(securityOpts),
+		middleware.RateLimit(log.WithName("ratelimit"), rateLimitRules, middleware.NewMemoryRateLimitStore(), server.wrapper.Router(), proxies),
+	)
+
 	return server, nil
 }
 
 func (s *frontendServer) Start(port string) {
 	addr := fmt.Sprintf("0.0.0.0:%s", port)
 	log.Info(fmt.Sprintf("Server is running on %s", addr))
-	if err := http.ListenAndServe(addr, s.wrapper.Router()); err != nil {
+	srv := &http.Server{
+		Addr:           addr,
+		Handler:        s.handler,
+		MaxHeaderBytes: s.maxHeaderBytes,
+	}
+	if err := srv.ListenAndServe(); err != nil {
 		log.Error(err, "cannot launch http server")
 		os.Exit(1)
 	}
 }
 
+// ServeHTTP serves a request with the middlewares, e.g., for the sub-requests of batch requests
+func (s *frontendServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.handler.ServeHTTP(w, req)
+}
+
 func (s *frontendServer) rootHandler(w http.ResponseWriter, _ *http.Request) {
 	paths := metav1.RootPaths{}
 	addPath(&paths.Paths, s.wrapper)
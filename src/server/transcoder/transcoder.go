@@ -0,0 +1,371 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package transcoder
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/apihandler"
+	"github.com/theraffle/frontservice/src/auth"
+	"github.com/theraffle/frontservice/src/utils"
+	"github.com/theraffle/frontservice/src/wrapper"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type handler struct {
+	ctx context.Context
+	log logr.Logger
+
+	bindings []*binding
+}
+
+// binding is an http rule of a rpc method
+type binding struct {
+	fullMethod string
+	conn       *grpc.ClientConn
+	input      protoreflect.MessageType
+	output     protoreflect.MessageType
+
+	httpMethod   string
+	template     string
+	path         string
+	pathFields   []string
+	body         string
+	responseBody protoreflect.FieldDescriptor
+
+	// userField is the path variable of the user id (e.g., userID, wallet.userID), if any.
+	// Such routes are served only for the authenticated user of the id
+	userField string
+}
+
+// userIDField is the name of the fields of the user ids
+const userIDField = "userID"
+
+// NewHandler exposes the rpc methods of file over http, by their google.api.http annotations.
+// conns are the grpc connections of the services by their full names (e.g., pb.UserService), and services without
+// a connection are not exposed. Routes which are already registered in the wrapper tree of parent override the annotated
+// ones, so NewHandler should be called after the hand-written handlers are added. Routes are added to the groups of
+// their paths in the tree (see routeGroup)
+func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.Logger, file protoreflect.FileDescriptor, conns map[protoreflect.FullName]*grpc.ClientConn) (apihandler.APIHandler, error) {
+	handler := &handler{ctx: ctx, log: logger}
+
+	registered := map[string]bool{}
+	addRoutes(registered, parent)
+
+	services := file.Services()
+	for i := 0; i < services.Len(); i++ {
+		svc := services.Get(i)
+		conn, ok := conns[svc.FullName()]
+		if !ok {
+			continue
+		}
+		methods := svc.Methods()
+		for j := 0; j < methods.Len(); j++ {
+			bindings, err := newBindings(conn, methods.Get(j))
+			if err != nil {
+				return nil, err
+			}
+			for _, b := range bindings {
+				route := routeKey(b.httpMethod, parent.FullPath()+b.path)
+				if registered[route] || registered[routeKey(anyMethod, parent.FullPath()+b.path)] {
+					logger.Info("annotated route is overridden by a handler", "method", b.httpMethod, "path", b.template, "rpc", b.fullMethod)
+					continue
+				}
+				registered[route] = true
+
+				group, subPath := routeGroup(parent, b.path)
+				if err := group.Add(wrapper.New(subPath, []string{b.httpMethod}, handler.transcode(b))); err != nil {
+					return nil, err
+				}
+				handler.bindings = append(handler.bindings, b)
+			}
+		}
+	}
+
+	return handler, nil
+}
+
+// newBindings reads the http rule and its additional bindings of the method
+func newBindings(conn *grpc.ClientConn, method protoreflect.MethodDescriptor) ([]*binding, error) {
+	rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return nil, nil
+	}
+	input, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return nil, err
+	}
+	output, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	fullMethod := fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+
+	var bindings []*binding
+	for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+		b := &binding{fullMethod: fullMethod, conn: conn, input: input, output: output, body: r.GetBody()}
+		switch p := r.GetPattern().(type) {
+		case *annotations.HttpRule_Get:
+			b.httpMethod, b.template = http.MethodGet, p.Get
+		case *annotations.HttpRule_Put:
+			b.httpMethod, b.template = http.MethodPut, p.Put
+		case *annotations.HttpRule_Post:
+			b.httpMethod, b.template = http.MethodPost, p.Post
+		case *annotations.HttpRule_Delete:
+			b.httpMethod, b.template = http.MethodDelete, p.Delete
+		case *annotations.HttpRule_Patch:
+			b.httpMethod, b.template = http.MethodPatch, p.Patch
+		case *annotations.HttpRule_Custom:
+			b.httpMethod, b.template = strings.ToUpper(p.Custom.GetKind()), p.Custom.GetPath()
+		default:
+			return nil, fmt.Errorf("%s: http rule has no pattern", fullMethod)
+		}
+
+		if b.path, b.pathFields, err = parseTemplate(b.template); err != nil {
+			return nil, fmt.Errorf("%s: %v", fullMethod, err)
+		}
+		for _, field := range b.pathFields {
+			if field == userIDField || strings.HasSuffix(field, "."+userIDField) {
+				b.userField = field
+			}
+		}
+		if b.body != "" && b.body != "*" {
+			fd := utils.FieldByName(method.Input(), b.body)
+			if fd == nil || fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+				return nil, fmt.Errorf("%s: body %q is not a message field", fullMethod, b.body)
+			}
+		}
+		if r.GetResponseBody() != "" {
+			fd := utils.FieldByName(method.Output(), r.GetResponseBody())
+			if fd == nil || fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+				return nil, fmt.Errorf("%s: response body %q is not a message field", fullMethod, r.GetResponseBody())
+			}
+			b.responseBody = fd
+		}
+		bindings = append(bindings, b)
+	}
+	return bindings, nil
+}
+
+// parseTemplate converts a path template of a http rule (e.g., /user/{userID}, /files/{name=**}) into a mux path,
+// returning the field paths bound to the path variables
+func parseTemplate(tmpl string) (string, []string, error) {
+	if !strings.HasPrefix(tmpl, "/") || len(tmpl) < 2 {
+		return "", nil, fmt.Errorf("invalid path template %q", tmpl)
+	}
+	var path strings.Builder
+	var fields []string
+	for len(tmpl) > 0 {
+		start := strings.IndexByte(tmpl, '{')
+		if start < 0 {
+			if strings.Contains(tmpl, "*") || strings.Contains(tmpl, "}") {
+				return "", nil, fmt.Errorf("invalid path template %q: wildcards must be bound to fields", tmpl)
+			}
+			path.WriteString(tmpl)
+			break
+		}
+		if strings.Contains(tmpl[:start], "*") || strings.Contains(tmpl[:start], "}") {
+			return "", nil, fmt.Errorf("invalid path template %q: wildcards must be bound to fields", tmpl)
+		}
+		path.WriteString(tmpl[:start])
+		end := strings.IndexByte(tmpl[start:], '}')
+		if end < 0 {
+			return "", nil, fmt.Errorf("invalid path template %q: unclosed variable", tmpl)
+		}
+		variable := tmpl[start+1 : start+end]
+		tmpl = tmpl[start+end+1:]
+
+		field, pattern := variable, ""
+		if i := strings.IndexByte(variable, '='); i >= 0 {
+			field, pattern = variable[:i], variable[i+1:]
+		}
+		if field == "" || strings.ContainsAny(field, ":/") {
+			return "", nil, fmt.Errorf("invalid path variable %q", variable)
+		}
+		fields = append(fields, field)
+		switch pattern {
+		case "", "*":
+			path.WriteString("{" + field + "}")
+		default:
+			path.WriteString("{" + field + ":" + segmentsRegexp(pattern) + "}")
+		}
+	}
+	return path.String(), fields, nil
+}
+
+// segmentsRegexp converts the segments of a path variable (e.g., shelves/*/books/**) into a regular expression
+func segmentsRegexp(pattern string) string {
+	segments := strings.Split(pattern, "/")
+	for i, s := range segments {
+		switch s {
+		case "*":
+			segments[i] = "[^/]+"
+		case "**":
+			segments[i] = ".+"
+		default:
+			segments[i] = regexp.QuoteMeta(s)
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// anyMethod is the method of the routes which are registered without methods
+const anyMethod = "*"
+
+var pathVariable = regexp.MustCompile(`\{[^}]*\}`)
+var slashes = regexp.MustCompile(`/{2,}`)
+
+// routeKey identifies a route by its method and path, regardless of the names of the path variables
+func routeKey(method, path string) string {
+	path = slashes.ReplaceAllString(path, "/")
+	return method + " " + pathVariable.ReplaceAllString(path, "{}")
+}
+
+// routeGroup returns the deepest wrapper under parent which groups the routes of a static path prefix of path
+// (e.g., /project for /project/{projectID}), i.e., a wrapper without a handler, with the rest of path.
+// Routes are added to their groups for the middlewares of the groups (e.g., the authorization) to apply
+func routeGroup(parent wrapper.RouterWrapper, path string) (wrapper.RouterWrapper, string) {
+	for _, c := range parent.Children() {
+		prefix := c.SubPath()
+		if c.Handler() != nil || strings.Contains(prefix, "{") || !strings.HasPrefix(path, prefix+"/") {
+			continue
+		}
+		return routeGroup(c, strings.TrimPrefix(path, prefix))
+	}
+	return parent, path
+}
+
+// addRoutes adds the routes of the wrapper tree which have handlers
+func addRoutes(routes map[string]bool, w wrapper.RouterWrapper) {
+	if w.Handler() != nil {
+		methods := w.Methods()
+		if len(methods) == 0 {
+			methods = []string{anyMethod}
+		}
+		for _, m := range methods {
+			routes[routeKey(m, w.FullPath())] = true
+		}
+	}
+	for _, c := range w.Children() {
+		addRoutes(routes, c)
+	}
+}
+
+// transcode handles the http requests of the binding, by calling the rpc method with the input message built from
+// the path variables, the body and the query parameters
+func (h *handler) transcode(b *binding) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		reqID := utils.RequestID(req)
+		log := h.log.WithValues("transcode_request", reqID, "rpc", b.fullMethod)
+
+		if b.userField != "" {
+			userID, err := strconv.ParseInt(mux.Vars(req)[b.userField], 10, 64)
+			if err != nil {
+				_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s", b.userField))
+				return
+			}
+			if !auth.AuthorizeUser(w, req, userID) {
+				return
+			}
+		}
+
+		in := b.input.New()
+		if b.body != "" {
+			target := in
+			if b.body != "*" {
+				target = in.Mutable(utils.FieldByName(in.Descriptor(), b.body)).Message()
+			}
+			if err := utils.DecodeMessage(req, target.Interface()); err != nil {
+				log.Error(err, "transcode error")
+				_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
+				return
+			}
+		}
+
+		vars := mux.Vars(req)
+		for _, field := range b.pathFields {
+			if err := setField(in, strings.Split(field, "."), []string{vars[field]}); err != nil {
+				_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s: %v", field, err))
+				return
+			}
+		}
+
+		if b.body != "*" {
+			for key, values := range req.URL.Query() {
+				if b.boundByPathOrBody(in.Descriptor(), key) {
+					continue
+				}
+				if err := setField(in, strings.Split(key, "."), values); err != nil && err != errUnknownField {
+					_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s: %v", key, err))
+					return
+				}
+			}
+		}
+
+		log.Info("transcoding request")
+		out := b.output.New().Interface()
+		if err := b.conn.Invoke(req.Context(), b.fullMethod, in.Interface(), out); err != nil {
+			log.Error(err, "")
+			_ = utils.RespondGRPCError(w, err)
+			return
+		}
+		if b.responseBody != nil {
+			out = out.ProtoReflect().Get(b.responseBody).Message().Interface()
+		}
+		_ = utils.Respond(w, req, out)
+	}
+}
+
+// boundByPathOrBody checks if the query parameter is a field bound by the path or the body, or a subfield of them
+func (b *binding) boundByPathOrBody(md protoreflect.MessageDescriptor, param string) bool {
+	fields := fieldPath(md, param)
+	if fields == nil {
+		return false
+	}
+	bound := b.pathFields
+	if b.body != "" {
+		bound = append(bound[:len(bound):len(bound)], b.body)
+	}
+	for _, field := range bound {
+		prefix := fieldPath(md, field)
+		if len(prefix) == 0 || len(prefix) > len(fields) {
+			continue
+		}
+		matched := true
+		for i := range prefix {
+			if prefix[i] != fields[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package transcoder
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/theraffle/frontservice/src/utils"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var errUnknownField = fmt.Errorf("unknown field")
+
+// fieldPath resolves a dot-separated field path (e.g., wallet.userID) of md. It returns nil if the path is not found
+func fieldPath(md protoreflect.MessageDescriptor, path string) []protoreflect.FieldDescriptor {
+	var fields []protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		if md == nil {
+			return nil
+		}
+		fd := utils.FieldByName(md, name)
+		if fd == nil {
+			return nil
+		}
+		fields = append(fields, fd)
+		md = nil
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			md = fd.Message()
+		}
+	}
+	return fields
+}
+
+// setField sets the field of msg at path by the string values of a path variable or a query parameter.
+// Repeated fields take all the values, and singular fields take the last one
+func setField(msg protoreflect.Message, path []string, values []string) error {
+	fd := utils.FieldByName(msg.Descriptor(), path[0])
+	if fd == nil {
+		return errUnknownField
+	}
+	if len(path) > 1 {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return errUnknownField
+		}
+		return setField(msg.Mutable(fd).Message(), path[1:], values)
+	}
+	if fd.IsMap() || fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return fmt.Errorf("%s cannot be set by a parameter", fd.Name())
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	if fd.IsList() {
+		list := msg.Mutable(fd).List()
+		for _, s := range values {
+			v, err := parseValue(fd, s)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	}
+	v, err := parseValue(fd, values[len(values)-1])
+	if err != nil {
+		return err
+	}
+	msg.Set(fd, v)
+	return nil
+}
+
+// parseValue parses a string into a value of the scalar field
+func parseValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		b, err := base64.URLEncoding.DecodeString(s)
+		if err != nil {
+			if b, err = base64.StdEncoding.DecodeString(s); err != nil {
+				return protoreflect.Value{}, fmt.Errorf("not in base64")
+			}
+		}
+		return protoreflect.ValueOfBytes(b), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("not a boolean")
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("not a 32-bit integer")
+		}
+		return protoreflect.ValueOfInt32(int32(i)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("not a 64-bit integer")
+		}
+		return protoreflect.ValueOfInt64(i), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		i, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("not an unsigned 32-bit integer")
+		}
+		return protoreflect.ValueOfUint32(uint32(i)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		i, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("not an unsigned 64-bit integer")
+		}
+		return protoreflect.ValueOfUint64(i), nil
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("not a number")
+		}
+		return protoreflect.ValueOfFloat32(float32(f)), nil
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("not a number")
+		}
+		return protoreflect.ValueOfFloat64(f), nil
+	case protoreflect.EnumKind:
+		if v := fd.Enum().Values().ByName(protoreflect.Name(strings.ToUpper(s))); v != nil {
+			return protoreflect.ValueOfEnum(v.Number()), nil
+		}
+		i, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || fd.Enum().Values().ByNumber(protoreflect.EnumNumber(i)) == nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown enum value %q", s)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(i)), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported type %s", fd.Kind())
+}
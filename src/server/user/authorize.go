@@ -0,0 +1,107 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package user
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/auth"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+// policy is who can call an api of a user
+type policy int
+
+const (
+	// self allows only the authenticated user of the path
+	self policy = iota
+	// public allows anyone, without the authentication
+	public
+)
+
+// policies are the policies of the apis of the users, by their path templates and methods. The other apis under
+// /user/{id}, including the ones overriding the transcoded ones, are denied until their policies are added here
+var policies = map[string]map[string]policy{
+	"/user/{id}": {
+		http.MethodGet: public,
+		http.MethodPut: self,
+	},
+	"/user/{id}/profile": {
+		http.MethodGet: public,
+	},
+	"/user/{id}/wallet": {
+		http.MethodPost: self,
+	},
+	"/user/{id}/wallet/{chainID}/{address}": {
+		http.MethodPatch:  self,
+		http.MethodDelete: self,
+	},
+	"/user/{id}/wallets": {
+		http.MethodGet: public,
+	},
+	"/user/{id}/project": {
+		http.MethodPost: self,
+	},
+	"/user/{id}/project/{projectID}": {
+		http.MethodPut:    self,
+		http.MethodDelete: self,
+	},
+	"/user/{id}/projects": {
+		http.MethodGet: public,
+	},
+}
+
+// authorize is the middleware checking the authenticated user against the policy of the api. It responds
+// 401 Unauthorized if the user is not authenticated, and 403 Forbidden if the user is not the user of the path
+// or the api has no policy
+func (h *handler) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		template := ""
+		if route := mux.CurrentRoute(req); route != nil {
+			template, _ = route.GetPathTemplate()
+		}
+		template = strings.TrimSuffix(template, "/")
+		p, ok := policies[template][req.Method]
+		if !ok {
+			h.log.Error(fmt.Errorf("no policy for the api"), "denying request", "method", req.Method, "path", template)
+			_ = utils.RespondError(w, http.StatusForbidden, "access to the api is not allowed")
+			return
+		}
+		if p == public {
+			next.ServeHTTP(w, req)
+			return
+		}
+		userID, err := strconv.ParseInt(mux.Vars(req)["id"], 10, 64)
+		if err != nil {
+			_ = utils.RespondError(w, http.StatusBadRequest, "user id must be an integer")
+			return
+		}
+		if !auth.AuthorizeUser(w, req, userID) {
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// authorized applies authorize to an api which is not under the group of /user/{id}, i.e., the user itself
+func (h *handler) authorized(handler http.HandlerFunc) http.HandlerFunc {
+	return h.authorize(handler).ServeHTTP
+}
@@ -0,0 +1,140 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/apihandler"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/server/user/userproject"
+	"github.com/theraffle/frontservice/src/utils"
+	"github.com/theraffle/frontservice/src/wrapper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// Sections of a profile
+const (
+	sectionUser     = "user"
+	sectionWallets  = "wallets"
+	sectionProjects = "projects"
+)
+
+type handler struct {
+	ctx            context.Context
+	log            logr.Logger
+	userSvcConn    *grpc.ClientConn
+	projectSvcConn *grpc.ClientConn
+
+	fanOut utils.FanOutOptions
+}
+
+// NewHandler instantiates a new apis handler
+func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, log logr.Logger, userSvcConn, projectSvcConn *grpc.ClientConn) (apihandler.APIHandler, error) {
+	handler := &handler{ctx: ctx, log: log, userSvcConn: userSvcConn, projectSvcConn: projectSvcConn}
+	fanOut, err := utils.FanOutOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	handler.fanOut = fanOut
+
+	// Get User Profile
+	getUserProfile := wrapper.New("/profile", []string{http.MethodGet}, handler.getUserProfileHandler)
+	if err := parent.Add(getUserProfile); err != nil {
+		return nil, err
+	}
+
+	return handler, nil
+}
+
+func (h handler) getUserProfileHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("get_user_profile_request", reqID)
+
+	userID, err := strconv.ParseInt(mux.Vars(req)["id"], 10, 64)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, "user id must be an integer")
+		return
+	}
+	expand := req.URL.Query().Get("expand")
+	if expand != "" && expand != userproject.ExpandProject {
+		_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("expand must be %s", userproject.ExpandProject))
+		return
+	}
+
+	log.Info("getting user profile", "id", userID, "expand", expand)
+
+	userSvcCli := pb.NewUserServiceClient(h.userSvcConn)
+	profile := &pb.UserProfile{Errors: map[string]*pb.ErrorDetail{}}
+	var userErr error
+	var lock sync.Mutex
+	fail := func(section string, err error) {
+		log.Error(err, "cannot get a section of user profile", "section", section)
+		lock.Lock()
+		defer lock.Unlock()
+		profile.Errors[section] = utils.ErrorDetailOf(err)
+	}
+
+	sections := []func(ctx context.Context){
+		func(ctx context.Context) {
+			resp, err := userSvcCli.GetUser(ctx, &pb.GetUserRequest{UserID: userID})
+			if err != nil {
+				userErr = err
+				fail(sectionUser, err)
+				return
+			}
+			profile.User = resp
+		},
+		func(ctx context.Context) {
+			resp, err := userSvcCli.GetUserWallet(ctx, &pb.GetUserWalletRequest{UserID: userID})
+			if err != nil {
+				fail(sectionWallets, err)
+				return
+			}
+			profile.Wallets = resp.Wallets
+		},
+		func(ctx context.Context) {
+			resp, err := userSvcCli.GetUserProject(ctx, &pb.GetUserProjectRequest{UserID: userID})
+			if err != nil {
+				fail(sectionProjects, err)
+				return
+			}
+			profile.Projects = userproject.Details(resp)
+		},
+	}
+	h.fanOut.FanOut(req.Context(), len(sections), func(ctx context.Context, i int) {
+		sections[i](ctx)
+	})
+
+	// The profile of a user who does not exist is not degraded, but not found
+	if status.Code(userErr) == codes.NotFound {
+		_ = utils.RespondGRPCError(w, userErr)
+		return
+	}
+
+	if expand == userproject.ExpandProject {
+		userproject.Expand(req.Context(), h.log, pb.NewProjectServiceClient(h.projectSvcConn), h.fanOut, profile.Projects)
+	}
+	_ = utils.Respond(w, req, profile)
+}
@@ -18,12 +18,12 @@ package user
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/go-logr/logr"
 	"github.com/gorilla/mux"
 	"github.com/theraffle/frontservice/src/apihandler"
 	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/server/user/profile"
 	"github.com/theraffle/frontservice/src/server/user/userproject"
 	"github.com/theraffle/frontservice/src/server/user/wallet"
 	"github.com/theraffle/frontservice/src/utils"
@@ -37,22 +37,36 @@ type handler struct {
 	ctx context.Context
 	log logr.Logger
 
-	userSvcAddr    string
 	userSvcConn    *grpc.ClientConn
+	projectSvcConn *grpc.ClientConn
 	projectHandler apihandler.APIHandler
 	walletHandler  apihandler.APIHandler
+	profileHandler apihandler.APIHandler
+
+	userLocks utils.KeyedMutex
 }
 
 type createUserReqBody struct {
-	UserID    string       `json:"user_id"`
-	LoginType pb.LoginType `json:"login_type"`
+	UserID    string    `json:"user_id"`
+	LoginType loginType `json:"login_type"`
+}
+
+// loginType is a pb.LoginType which accepts its name (e.g., "DISCORD") as well as its number in JSON
+type loginType pb.LoginType
+
+// UnmarshalJSON unmarshals the login type from its name or number
+func (l *loginType) UnmarshalJSON(data []byte) error {
+	v, err := utils.UnmarshalEnum(data, pb.LoginType_value)
+	if err != nil {
+		return err
+	}
+	*l = loginType(v)
+	return nil
 }
 
 // NewHandler instantiates a new apis handler
-func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.Logger) (apihandler.APIHandler, error) {
-	handler := &handler{ctx: ctx, log: logger}
-	utils.MustMapEnv(&handler.userSvcAddr, "USER_SERVICE_ADDR")
-	utils.MustConnGRPC(ctx, &handler.userSvcConn, handler.userSvcAddr)
+func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.Logger, userSvcConn, projectSvcConn *grpc.ClientConn) (apihandler.APIHandler, error) {
+	handler := &handler{ctx: ctx, log: logger, userSvcConn: userSvcConn, projectSvcConn: projectSvcConn}
 
 	// Create User & Login
 	createUser := wrapper.New("/user", []string{http.MethodPost}, handler.createUserHandler)
@@ -61,24 +75,26 @@ func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.L
 	}
 
 	// Get User
-	getUser := wrapper.New("/user/{id}", []string{http.MethodGet}, handler.getUserHandler)
+	getUser := wrapper.New("/user/{id}", []string{http.MethodGet}, handler.authorized(handler.getUserHandler))
 	if err := parent.Add(getUser); err != nil {
 		return nil, err
 	}
 
 	// Edit User
-	updateUser := wrapper.New("/user/{id}", []string{http.MethodPut}, handler.updateUserHandler)
+	updateUser := wrapper.New("/user/{id}", []string{http.MethodPut}, handler.authorized(handler.updateUserHandler))
 	if err := parent.Add(updateUser); err != nil {
 		return nil, err
 	}
 
+	// The apis under /user/{id} are authorized by their policies, e.g., only the user can change the wallets
 	userWrapper := wrapper.New("/user/{id}", nil, nil)
 	if err := parent.Add(userWrapper); err != nil {
 		return nil, err
 	}
+	userWrapper.Router().Use(handler.authorize)
 
 	// /user/{id}/project
-	projectHandler, err := userproject.NewHandler(ctx, userWrapper, logger, handler.userSvcConn)
+	projectHandler, err := userproject.NewHandler(ctx, userWrapper, logger, handler.userSvcConn, handler.projectSvcConn)
 	if err != nil {
 		return nil, err
 	}
@@ -91,34 +107,41 @@ func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, logger logr.L
 	}
 	handler.walletHandler = walletHandler
 
+	// /user/{id}/profile
+	profileHandler, err := profile.NewHandler(ctx, userWrapper, logger, handler.userSvcConn, handler.projectSvcConn)
+	if err != nil {
+		return nil, err
+	}
+	handler.profileHandler = profileHandler
+
 	return handler, nil
 }
 
 func (h *handler) createUserHandler(w http.ResponseWriter, req *http.Request) {
-	reqID := utils.RandomString(10)
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("request", reqID)
 
 	log.Info("create user request")
 	// Decode request body
 	createUserReq := &createUserReqBody{}
-	decoder := json.NewDecoder(req.Body)
-	if err := decoder.Decode(createUserReq); err != nil {
+	if err := utils.DecodeBody(req, createUserReq, &pb.LoginUserRequest{}); err != nil {
 		h.log.Error(err, "create user error")
-		_ = utils.RespondError(w, http.StatusBadRequest, "request body is not in json form or is malformed")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
 		return
 	}
 	// TODO request validity check
 
-	resp, err := pb.NewUserServiceClient(h.userSvcConn).LoginUser(h.ctx, &pb.LoginUserRequest{UserID: createUserReq.UserID, LoginType: createUserReq.LoginType})
+	resp, err := pb.NewUserServiceClient(h.userSvcConn).LoginUser(h.ctx, &pb.LoginUserRequest{UserID: createUserReq.UserID, LoginType: pb.LoginType(createUserReq.LoginType)})
 	if err != nil {
 		h.log.Error(err, "")
 		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		return
 	}
-	_ = utils.RespondJSON(w, resp)
+	_ = utils.Respond(w, req, resp)
 }
 
 func (h *handler) getUserHandler(w http.ResponseWriter, req *http.Request) {
-	reqID := utils.RandomString(10)
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("get_user_request", reqID)
 	id := mux.Vars(req)["id"]
 	if id == "" {
@@ -130,13 +153,17 @@ func (h *handler) getUserHandler(w http.ResponseWriter, req *http.Request) {
 	resp, err := pb.NewUserServiceClient(h.userSvcConn).GetUser(h.ctx, &pb.GetUserRequest{UserID: int64(intID)})
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	if utils.CheckNotModified(w, req, utils.ETag(resp)) {
+		return
 	}
-	_ = utils.RespondJSON(w, resp)
+	_ = utils.Respond(w, req, resp)
 }
 
 func (h *handler) updateUserHandler(w http.ResponseWriter, req *http.Request) {
-	reqID := utils.RandomString(10)
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("get_user_request", reqID)
 	id := mux.Vars(req)["id"]
 	if id == "" {
@@ -145,21 +172,38 @@ func (h *handler) updateUserHandler(w http.ResponseWriter, req *http.Request) {
 	}
 	// Decode request body
 	updateUserReq := &createUserReqBody{}
-	decoder := json.NewDecoder(req.Body)
-	if err := decoder.Decode(updateUserReq); err != nil {
+	if err := utils.DecodeBody(req, updateUserReq, &pb.LoginUserRequest{}); err != nil {
 		h.log.Error(err, "create user error")
-		_ = utils.RespondError(w, http.StatusBadRequest, "request body is not in json form or is malformed")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
+		return
+	}
+
+	switch pb.LoginType(updateUserReq.LoginType) {
+	case pb.LoginType_DISCORD, pb.LoginType_TELEGRAM, pb.LoginType_TWITTER:
+	default:
+		err := fmt.Errorf("invalid id type")
+		h.log.Error(err, "")
+		_ = utils.RespondError(w, http.StatusBadRequest, "invalid id type")
 		return
 	}
 
 	log.Info("updating user info", "id", id)
 
 	intID, _ := strconv.Atoi(id)
+	// Serialize the read-modify-write of the user, in addition to the If-Match check,
+	// within this replica only, since the service does not take the expected version (see docs/api.md)
+	unlock := h.userLocks.Lock(id)
+	defer unlock()
+
 	userSvcCli := pb.NewUserServiceClient(h.userSvcConn)
 	resp, err := userSvcCli.GetUser(h.ctx, &pb.GetUserRequest{UserID: int64(intID)})
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	if !utils.CheckPrecondition(w, req, utils.ETag(resp)) {
+		return
 	}
 
 	rpcReq := &pb.UpdateUserRequest{
@@ -169,22 +213,21 @@ func (h *handler) updateUserHandler(w http.ResponseWriter, req *http.Request) {
 		TwitterID:  resp.TwitterID,
 	}
 
-	if updateUserReq.LoginType == pb.LoginType_DISCORD {
+	switch pb.LoginType(updateUserReq.LoginType) {
+	case pb.LoginType_DISCORD:
 		rpcReq.DiscordID = updateUserReq.UserID
-	} else if updateUserReq.LoginType == pb.LoginType_TELEGRAM {
+	case pb.LoginType_TELEGRAM:
 		rpcReq.TelegramID = updateUserReq.UserID
-	} else if updateUserReq.LoginType == pb.LoginType_TWITTER {
+	case pb.LoginType_TWITTER:
 		rpcReq.TwitterID = updateUserReq.UserID
-	} else {
-		err = fmt.Errorf("invalid id type")
-		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "invalid id type")
 	}
 
 	resp, err = userSvcCli.UpdateUser(h.ctx, rpcReq)
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "update user error")
+		_ = utils.RespondGRPCError(w, err)
+		return
 	}
-	_ = utils.RespondJSON(w, resp)
+	w.Header().Set("ETag", utils.ETag(resp))
+	_ = utils.Respond(w, req, resp)
 }
@@ -0,0 +1,150 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package userproject
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/theraffle/frontservice/src/auth"
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/raffle"
+	"github.com/theraffle/frontservice/src/utils"
+)
+
+type updateUserProjectReqBody struct {
+	ChainID int64  `json:"chain_id,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
+// entryIDs parses the user id and the project id of the entry in the path
+func entryIDs(req *http.Request) (int64, int64, error) {
+	userID, err := strconv.ParseInt(mux.Vars(req)["id"], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("user id not specified")
+	}
+	projectID, err := strconv.ParseInt(mux.Vars(req)["projectID"], 10, 64)
+	if err != nil || projectID <= 0 {
+		return 0, 0, fmt.Errorf("project id must be a positive integer")
+	}
+	return userID, projectID, nil
+}
+
+// updateUserProjectHandler changes the chain and the address of the entry, while the project is open
+func (h handler) updateUserProjectHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("update_user_project_request", reqID)
+
+	userID, projectID, err := entryIDs(req)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !auth.AuthorizeUser(w, req, userID) {
+		return
+	}
+	// Decode request body
+	updateUserProjectReq := &updateUserProjectReqBody{}
+	if err := utils.DecodeBody(req, updateUserProjectReq, &pb.UpdateUserProjectRequest{}); err != nil {
+		h.log.Error(err, "update user project error")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
+		return
+	}
+	entry := &pb.ProjectEntry{
+		UserID:    userID,
+		ProjectID: projectID,
+		ChainID:   updateUserProjectReq.ChainID,
+		Address:   updateUserProjectReq.Address,
+	}
+	if err := utils.ValidateAddress(entry.Address); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("invalid address: %v", err))
+		return
+	}
+	entry.Address = utils.ChecksumAddress(entry.Address)
+
+	log.Info("update user project", "id", userID, "project_id", projectID)
+
+	project, ok := h.openProject(w, log, projectID)
+	if !ok {
+		return
+	}
+
+	// Serialize the check and the change with the other entries of the project
+	unlock := h.entryLocks.Lock(strconv.FormatInt(projectID, 10))
+	defer unlock()
+
+	entrant, err := h.entrant(entry, false, true)
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	if !raffle.HasEntered(entrant.UserEntries, projectID) {
+		_ = utils.RespondError(w, http.StatusNotFound, "user has not entered the project")
+		return
+	}
+	if err := raffle.CheckEntryChange(project, entry, entrant); err != nil {
+		respondIneligible(w, log, entry, err)
+		return
+	}
+
+	resp, err := pb.NewUserServiceClient(h.userSvcConn).UpdateUserProject(h.ctx, &pb.UpdateUserProjectRequest{
+		UserID:    entry.UserID,
+		ProjectID: entry.ProjectID,
+		ChainID:   entry.ChainID,
+		Address:   entry.Address,
+	})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, resp)
+}
+
+// deleteUserProjectHandler withdraws the entry, while the project is open
+func (h handler) deleteUserProjectHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("delete_user_project_request", reqID)
+
+	userID, projectID, err := entryIDs(req)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !auth.AuthorizeUser(w, req, userID) {
+		return
+	}
+
+	log.Info("delete user project", "id", userID, "project_id", projectID)
+
+	if _, ok := h.openProject(w, log, projectID); !ok {
+		return
+	}
+	resp, err := pb.NewUserServiceClient(h.userSvcConn).DeleteUserProject(h.ctx, &pb.DeleteUserProjectRequest{
+		UserID:    userID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, resp)
+}
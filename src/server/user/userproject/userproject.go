@@ -18,27 +18,39 @@ package userproject
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"github.com/go-logr/logr"
 	"github.com/gorilla/mux"
 	"github.com/theraffle/frontservice/src/apihandler"
 	"github.com/theraffle/frontservice/src/genproto/pb"
+	"github.com/theraffle/frontservice/src/raffle"
+	"github.com/theraffle/frontservice/src/server/user/wallet"
 	"github.com/theraffle/frontservice/src/utils"
 	"github.com/theraffle/frontservice/src/wrapper"
 	"google.golang.org/grpc"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 type handler struct {
-	ctx         context.Context
-	log         logr.Logger
-	userSvcConn *grpc.ClientConn
+	ctx            context.Context
+	log            logr.Logger
+	userSvcConn    *grpc.ClientConn
+	projectSvcConn *grpc.ClientConn
+
+	fanOut     utils.FanOutOptions
+	entryLocks *utils.KeyedMutex
 }
 
 // NewHandler instantiates a new apis handler
-func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, log logr.Logger, userSvcConn *grpc.ClientConn) (apihandler.APIHandler, error) {
-	handler := &handler{ctx: ctx, log: log, userSvcConn: userSvcConn}
+func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, log logr.Logger, userSvcConn, projectSvcConn *grpc.ClientConn) (apihandler.APIHandler, error) {
+	handler := &handler{ctx: ctx, log: log, userSvcConn: userSvcConn, projectSvcConn: projectSvcConn, entryLocks: &utils.KeyedMutex{}}
+	fanOut, err := utils.FanOutOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	handler.fanOut = fanOut
 
 	// Create User Project
 	createUserProject := wrapper.New("/project", []string{http.MethodPost}, handler.createUserProjectHandler)
@@ -46,6 +58,18 @@ func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, log logr.Logg
 		return nil, err
 	}
 
+	// Edit User Project
+	updateUserProject := wrapper.New("/{projectID}", []string{http.MethodPut}, handler.updateUserProjectHandler)
+	if err := createUserProject.Add(updateUserProject); err != nil {
+		return nil, err
+	}
+
+	// Withdraw User Project
+	deleteUserProject := wrapper.New("/{projectID}", []string{http.MethodDelete}, handler.deleteUserProjectHandler)
+	if err := createUserProject.Add(deleteUserProject); err != nil {
+		return nil, err
+	}
+
 	// Get User Projects
 	getUserProjects := wrapper.New("/projects", []string{http.MethodGet}, handler.getUserProjectsHandler)
 	if err := parent.Add(getUserProjects); err != nil {
@@ -62,7 +86,7 @@ type createUserProjectReqBody struct {
 }
 
 func (h handler) createUserProjectHandler(w http.ResponseWriter, req *http.Request) {
-	reqID := utils.RandomString(10)
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("create_user_project_request", reqID)
 
 	id := mux.Vars(req)["id"]
@@ -76,29 +100,150 @@ func (h handler) createUserProjectHandler(w http.ResponseWriter, req *http.Reque
 	intID, _ := strconv.Atoi(id)
 	// Decode request body
 	createUserProjectReq := &createUserProjectReqBody{}
-	decoder := json.NewDecoder(req.Body)
-	if err := decoder.Decode(createUserProjectReq); err != nil {
+	if err := utils.DecodeBody(req, createUserProjectReq, &pb.CreateUserProjectRequest{}); err != nil {
 		h.log.Error(err, "create user project error")
-		_ = utils.RespondError(w, http.StatusBadRequest, "request body is not in json form or is malformed")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
 		return
 	}
-	// TODO request validity check
-
-	resp, err := pb.NewUserServiceClient(h.userSvcConn).CreateUserProject(h.ctx, &pb.CreateUserProjectRequest{
+	entry := &pb.ProjectEntry{
 		UserID:    int64(intID),
 		ProjectID: createUserProjectReq.ProjectID,
 		ChainID:   createUserProjectReq.ChainID,
 		Address:   createUserProjectReq.Address,
+	}
+	if entry.Address != "" {
+		if err := utils.ValidateAddress(entry.Address); err != nil {
+			_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("invalid address: %v", err))
+			return
+		}
+	}
+
+	project, ok := h.openProject(w, log, entry.ProjectID)
+	if !ok {
+		return
+	}
+	if entry.Address == "" && !h.defaultAddress(w, entry, project) {
+		return
+	}
+	// Addresses are checked and stored in the checksum encoding, not to be told apart by their cases
+	entry.Address = utils.ChecksumAddress(entry.Address)
+
+	// Serialize the eligibility check and the entry of the project, for the entries to be unique within this replica.
+	// Across replicas, the User Service rejects the duplicates with ALREADY_EXISTS
+	unlock := h.entryLocks.Lock(strconv.FormatInt(entry.ProjectID, 10))
+	defer unlock()
+
+	rules := project.Eligibility
+	entrant, err := h.entrant(entry, raffle.NeedsUser(rules), rules.GetRequireWallet())
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	if err := raffle.CheckEligibility(project, entry, entrant, time.Now()); err != nil {
+		respondIneligible(w, log, entry, err)
+		return
+	}
+
+	resp, err := pb.NewUserServiceClient(h.userSvcConn).CreateUserProject(h.ctx, &pb.CreateUserProjectRequest{
+		UserID:    entry.UserID,
+		ProjectID: entry.ProjectID,
+		ChainID:   entry.ChainID,
+		Address:   entry.Address,
 	})
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, resp)
+}
+
+// openProject gets the project, and responds 409 Conflict if it is not open for the entries.
+// It returns false if the response is done
+func (h handler) openProject(w http.ResponseWriter, log logr.Logger, projectID int64) (*pb.Project, bool) {
+	resp, err := pb.NewProjectServiceClient(h.projectSvcConn).GetProject(h.ctx, &pb.GetProjectRequest{ProjectID: projectID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return nil, false
 	}
-	_ = utils.RespondJSON(w, resp)
+	if err := raffle.CheckOpen(resp.Project, time.Now()); err != nil {
+		log.Info("project is not open", "project_id", projectID, "reason", err.Error())
+		_ = utils.RespondError(w, http.StatusConflict, err.Error())
+		return nil, false
+	}
+	return resp.Project, true
+}
+
+// defaultAddress enters the entry with the primary wallet of the user on the chain, which defaults to the chain of
+// the project. It returns false if the response is done
+func (h handler) defaultAddress(w http.ResponseWriter, entry *pb.ProjectEntry, project *pb.Project) bool {
+	if entry.ChainID == 0 {
+		entry.ChainID = project.ChainID
+	}
+	wallets, err := pb.NewUserServiceClient(h.userSvcConn).GetUserWallet(h.ctx, &pb.GetUserWalletRequest{UserID: entry.UserID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return false
+	}
+	primary := wallet.Primary(wallets.Wallets, entry.ChainID)
+	if primary == nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("address is required, as the user has no primary wallet on chain %d", entry.ChainID))
+		return false
+	}
+	entry.Address = primary.Address
+	return true
+}
+
+// respondIneligible responds 403 Forbidden with the rule which rejects the entry as the reason
+func respondIneligible(w http.ResponseWriter, log logr.Logger, entry *pb.ProjectEntry, err error) {
+	rule := ""
+	if ineligible, ok := err.(*raffle.IneligibleError); ok {
+		rule = ineligible.Rule
+	}
+	log.Info("rejecting ineligible entry", "project_id", entry.ProjectID, "rule", rule)
+	_ = utils.RespondErrorReason(w, http.StatusForbidden, rule, err.Error())
+}
+
+// entrant gets what the eligibility of the entry is checked against. The user and the wallets are only got if needed
+func (h handler) entrant(entry *pb.ProjectEntry, needsUser, needsWallets bool) (raffle.Entrant, error) {
+	userSvcCli := pb.NewUserServiceClient(h.userSvcConn)
+	entrant := raffle.Entrant{}
+	if needsUser {
+		user, err := userSvcCli.GetUser(h.ctx, &pb.GetUserRequest{UserID: entry.UserID})
+		if err != nil {
+			return entrant, err
+		}
+		entrant.User = user
+	}
+	if needsWallets {
+		wallets, err := userSvcCli.GetUserWallet(h.ctx, &pb.GetUserWalletRequest{UserID: entry.UserID})
+		if err != nil {
+			return entrant, err
+		}
+		entrant.Wallets = wallets.Wallets
+	}
+	userEntries, err := userSvcCli.GetUserProject(h.ctx, &pb.GetUserProjectRequest{UserID: entry.UserID})
+	if err != nil {
+		return entrant, err
+	}
+	entrant.UserEntries = userEntries
+	addressEntries, err := userSvcCli.ListProjectEntries(h.ctx, &pb.ListProjectEntriesRequest{
+		ProjectID: entry.ProjectID,
+		PageSize:  1,
+		Address:   entry.Address,
+	})
+	if err != nil {
+		return entrant, err
+	}
+	entrant.AddressEntries = addressEntries.Entries
+	return entrant, nil
 }
 
 func (h handler) getUserProjectsHandler(w http.ResponseWriter, req *http.Request) {
-	reqID := utils.RandomString(10)
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("get_user_project_request", reqID)
 
 	id := mux.Vars(req)["id"]
@@ -106,14 +251,65 @@ func (h handler) getUserProjectsHandler(w http.ResponseWriter, req *http.Request
 		_ = utils.RespondError(w, http.StatusBadRequest, "user id not specified")
 		return
 	}
+	expand := req.URL.Query().Get("expand")
+	if expand != "" && expand != ExpandProject {
+		_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("expand must be %s", ExpandProject))
+		return
+	}
 
-	log.Info("getting user projects info", "id", id)
+	log.Info("getting user projects info", "id", id, "expand", expand)
 
 	intID, _ := strconv.Atoi(id)
 	resp, err := pb.NewUserServiceClient(h.userSvcConn).GetUserProject(h.ctx, &pb.GetUserProjectRequest{UserID: int64(intID)})
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		_ = utils.RespondGRPCError(w, err)
+		return
 	}
-	_ = utils.RespondJSON(w, resp)
+	if expand == "" {
+		_ = utils.Respond(w, req, resp)
+		return
+	}
+	_ = utils.Respond(w, req, h.expandProjects(req.Context(), resp))
+}
+
+// ExpandProject is the expand option, which embeds the projects into the entries of the user
+const ExpandProject = "project"
+
+// expandProjects gets the projects of the entries concurrently. Failures are reported per entry
+func (h handler) expandProjects(ctx context.Context, resp *pb.GetUserProjectResponse) *pb.GetUserProjectDetailsResponse {
+	details := &pb.GetUserProjectDetailsResponse{Projects: Details(resp)}
+	Expand(ctx, h.log, pb.NewProjectServiceClient(h.projectSvcConn), h.fanOut, details.Projects)
+	return details
+}
+
+// Details converts the entries of a user into UserProjectDetails, without the projects
+func Details(resp *pb.GetUserProjectResponse) []*pb.UserProjectDetail {
+	var details []*pb.UserProjectDetail
+	if len(resp.Entries) > 0 {
+		for _, e := range resp.Entries {
+			details = append(details, &pb.UserProjectDetail{ProjectID: e.ProjectID, ChainID: e.ChainID, Address: e.Address})
+		}
+		return details
+	}
+	// The user service does not report the entries, i.e., the chains and the addresses
+	for _, projectID := range resp.Projects {
+		details = append(details, &pb.UserProjectDetail{ProjectID: projectID})
+	}
+	return details
+}
+
+// Expand gets the projects of the details concurrently. Failures are reported by the errors of the details
+func Expand(ctx context.Context, log logr.Logger, projectSvcCli pb.ProjectServiceClient, fanOut utils.FanOutOptions, details []*pb.UserProjectDetail) {
+	fanOut.FanOut(ctx, len(details), func(ctx context.Context, i int) {
+		detail := details[i]
+		projectResp, err := projectSvcCli.GetProject(ctx, &pb.GetProjectRequest{ProjectID: detail.ProjectID})
+		if err != nil {
+			log.Error(err, "cannot get project", "project_id", detail.ProjectID)
+			detail.Error = utils.ErrorDetailOf(err)
+			return
+		}
+		raffle.ApplyEffectiveStatus(projectResp.Project, time.Now())
+		detail.Project = projectResp.Project
+	})
 }
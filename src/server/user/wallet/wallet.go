@@ -19,31 +19,51 @@ package wallet
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/go-logr/logr"
 	"github.com/gorilla/mux"
 	"github.com/theraffle/frontservice/src/apihandler"
+	"github.com/theraffle/frontservice/src/auth"
 	"github.com/theraffle/frontservice/src/genproto/pb"
 	"github.com/theraffle/frontservice/src/utils"
 	"github.com/theraffle/frontservice/src/wrapper"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"mime"
 	"net/http"
+	"os"
+	"sort"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
+const maxLabelLength = 50
+
 type handler struct {
 	ctx         context.Context
 	log         logr.Logger
 	userSvcConn *grpc.ClientConn
+
+	chainIDs    utils.ChainIDs
+	walletLocks *utils.KeyedMutex
 }
 
 type createUserWalletReqBody struct {
 	ChainID int64  `json:"chain_id,omitempty"`
 	Address string `json:"address,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Label   string `json:"label,omitempty"`
 }
 
 // NewHandler instantiates a new apis handler
 func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, log logr.Logger, userSvcConn *grpc.ClientConn) (apihandler.APIHandler, error) {
-	handler := &handler{ctx: ctx, log: log, userSvcConn: userSvcConn}
+	handler := &handler{ctx: ctx, log: log, userSvcConn: userSvcConn, walletLocks: &utils.KeyedMutex{}}
+	chainIDs, err := utils.ParseChainIDs(os.Getenv("SUPPORTED_CHAIN_IDS"))
+	if err != nil {
+		return nil, err
+	}
+	handler.chainIDs = chainIDs
 
 	// Create User Wallet
 	createUserWallet := wrapper.New("/wallet", []string{http.MethodPost}, handler.createUserWalletHandler)
@@ -51,6 +71,18 @@ func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, log logr.Logg
 		return nil, err
 	}
 
+	// Edit User Wallet
+	updateUserWallet := wrapper.New("/{chainID}/{address}", []string{http.MethodPatch}, handler.updateUserWalletHandler)
+	if err := createUserWallet.Add(updateUserWallet); err != nil {
+		return nil, err
+	}
+
+	// Delete User Wallet
+	deleteUserWallet := wrapper.New("/{chainID}/{address}", []string{http.MethodDelete}, handler.deleteUserWalletHandler)
+	if err := createUserWallet.Add(deleteUserWallet); err != nil {
+		return nil, err
+	}
+
 	// Get User Wallets
 	getUserWallet := wrapper.New("/wallets", []string{http.MethodGet}, handler.getUserWalletHandler)
 	if err := parent.Add(getUserWallet); err != nil {
@@ -61,59 +93,296 @@ func NewHandler(ctx context.Context, parent wrapper.RouterWrapper, log logr.Logg
 }
 
 func (h handler) createUserWalletHandler(w http.ResponseWriter, req *http.Request) {
-	reqID := utils.RandomString(10)
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("request", reqID)
 
-	id := mux.Vars(req)["id"]
-	if id == "" {
-		_ = utils.RespondError(w, http.StatusBadRequest, "user id not specified")
+	userID, err := strconv.ParseInt(mux.Vars(req)["id"], 10, 64)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, "user id must be an integer")
+		return
+	}
+	if !auth.AuthorizeUser(w, req, userID) {
 		return
 	}
 
-	log.Info("create user wallet", "id", id)
+	log.Info("create user wallet", "id", userID)
 
-	intID, _ := strconv.Atoi(id)
 	// Decode request body
 	createUserWalletReq := &createUserWalletReqBody{}
-	decoder := json.NewDecoder(req.Body)
-	if err := decoder.Decode(createUserWalletReq); err != nil {
+	if err := utils.DecodeBody(req, createUserWalletReq, &pb.UserWallet{}); err != nil {
 		h.log.Error(err, "create user wallet error")
-		_ = utils.RespondError(w, http.StatusBadRequest, "request body is not in json form or is malformed")
+		_ = utils.RespondDecodeError(w, err, "request body is not in json form or is malformed")
+		return
+	}
+	wallet := &pb.UserWallet{
+		UserID:  userID,
+		ChainID: createUserWalletReq.ChainID,
+		Address: createUserWalletReq.Address,
+		Primary: createUserWalletReq.Primary,
+		Label:   createUserWalletReq.Label,
+	}
+	if err := h.validateWallet(wallet); err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	// TODO request validity check
 
-	resp, err := pb.NewUserServiceClient(h.userSvcConn).CreateUserWallet(h.ctx, &pb.CreateUserWalletRequest{
-		Wallet: &pb.UserWallet{
-			UserID:  int64(intID),
-			ChainID: createUserWalletReq.ChainID,
-			Address: createUserWalletReq.Address,
-		},
-	})
+	// Serialize the changes of the primary wallets of the user
+	unlock := h.walletLocks.Lock(strconv.FormatInt(wallet.UserID, 10))
+	defer unlock()
+
+	userSvcCli := pb.NewUserServiceClient(h.userSvcConn)
+	wallets, err := userSvcCli.GetUserWallet(h.ctx, &pb.GetUserWalletRequest{UserID: wallet.UserID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	if Find(wallets.Wallets, wallet.ChainID, wallet.Address) != nil {
+		_ = utils.RespondError(w, http.StatusConflict, "wallet is already linked")
+		return
+	}
+	// The first wallet on a chain is the primary one
+	if Primary(wallets.Wallets, wallet.ChainID) == nil {
+		wallet.Primary = true
+	}
+
+	resp, err := userSvcCli.CreateUserWallet(h.ctx, &pb.CreateUserWalletRequest{Wallet: wallet})
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		_ = utils.RespondGRPCError(w, err)
+		return
 	}
-	_ = utils.RespondJSON(w, resp)
+	_ = utils.Respond(w, req, resp)
+}
+
+// validateWallet checks the chain, the address and the label of the wallet
+func (h handler) validateWallet(wallet *pb.UserWallet) error {
+	if err := h.chainIDs.ValidateChainID(wallet.ChainID); err != nil {
+		return fmt.Errorf("invalid chain_id: %v", err)
+	}
+	if err := utils.ValidateAddress(wallet.Address); err != nil {
+		return fmt.Errorf("invalid address: %v", err)
+	}
+	if utf8.RuneCountInString(wallet.Label) > maxLabelLength {
+		return fmt.Errorf("label must be at most %d characters", maxLabelLength)
+	}
+	return nil
+}
+
+// Find returns the wallet on the chain with the address, or nil if there is not
+func Find(wallets []*pb.UserWallet, chainID int64, address string) *pb.UserWallet {
+	for _, w := range wallets {
+		if w.ChainID == chainID && strings.EqualFold(w.Address, address) {
+			return w
+		}
+	}
+	return nil
+}
+
+// Primary returns the primary wallet on the chain, or nil if there is not
+func Primary(wallets []*pb.UserWallet, chainID int64) *pb.UserWallet {
+	for _, w := range wallets {
+		if w.ChainID == chainID && w.Primary {
+			return w
+		}
+	}
+	return nil
 }
 
 func (h handler) getUserWalletHandler(w http.ResponseWriter, req *http.Request) {
-	reqID := utils.RandomString(10)
+	reqID := utils.RequestID(req)
 	log := h.log.WithValues("request", reqID)
 
-	id := mux.Vars(req)["id"]
-	if id == "" {
-		_ = utils.RespondError(w, http.StatusBadRequest, "user id not specified")
+	userID, err := strconv.ParseInt(mux.Vars(req)["id"], 10, 64)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, "user id must be an integer")
+		return
+	}
+
+	log.Info("getting user wallet info", "id", userID)
+
+	resp, err := pb.NewUserServiceClient(h.userSvcConn).GetUserWallet(h.ctx, &pb.GetUserWalletRequest{UserID: userID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, resp)
+}
+
+// walletOf parses the user id, the chain id and the address of the wallet in the path
+func walletOf(req *http.Request) (*pb.UserWallet, error) {
+	vars := mux.Vars(req)
+	userID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("user id must be an integer")
+	}
+	chainID, err := strconv.ParseInt(vars["chainID"], 10, 64)
+	if err != nil || chainID <= 0 {
+		return nil, fmt.Errorf("chain id must be a positive integer")
+	}
+	if err := utils.ValidateAddress(vars["address"]); err != nil {
+		return nil, fmt.Errorf("invalid address: %v", err)
+	}
+	return &pb.UserWallet{UserID: userID, ChainID: chainID, Address: vars["address"]}, nil
+}
+
+// updateUserWalletHandler updates primary and label of the wallet, by JSON merge patch (RFC 7396)
+func (h handler) updateUserWalletHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("update_user_wallet_request", reqID)
+
+	wallet, err := walletOf(req)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !auth.AuthorizeUser(w, req, wallet.UserID) {
+		return
+	}
+	if mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type")); mediaType != utils.ContentTypeMergePatch && mediaType != utils.ContentTypeJSON {
+		_ = utils.RespondError(w, http.StatusUnsupportedMediaType, fmt.Sprintf("content type must be %s", utils.ContentTypeMergePatch))
 		return
 	}
+	// Decode request body
+	body, err := utils.ReadBody(req)
+	if err != nil {
+		h.log.Error(err, "update user wallet error")
+		_ = utils.RespondDecodeError(w, err, "cannot read request body")
+		return
+	}
+	patch := map[string]json.RawMessage{}
+	update := &pb.UserWallet{}
+	if err := json.Unmarshal(body, &patch); err != nil || utils.UnmarshalProtoJSON(body, update) != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, "request body is not a json merge patch of a wallet")
+		return
+	}
+	var paths []string
+	md := update.ProtoReflect().Descriptor()
+	for key := range patch {
+		fd := utils.FieldByName(md, key)
+		if fd == nil || (fd.Name() != "primary" && fd.Name() != "label") {
+			_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("field %s cannot be updated", key))
+			return
+		}
+		paths = append(paths, string(fd.Name()))
+	}
+	if len(paths) == 0 {
+		_ = utils.RespondError(w, http.StatusBadRequest, "patch has no fields")
+		return
+	}
+	sort.Strings(paths)
+	wallet.Primary, wallet.Label = update.Primary, update.Label
+	if utf8.RuneCountInString(wallet.Label) > maxLabelLength {
+		_ = utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("label must be at most %d characters", maxLabelLength))
+		return
+	}
+
+	log.Info("update user wallet", "id", wallet.UserID, "chain_id", wallet.ChainID, "address", wallet.Address, "fields", paths)
 
-	log.Info("getting user wallet info", "id", id)
+	unlock := h.walletLocks.Lock(strconv.FormatInt(wallet.UserID, 10))
+	defer unlock()
+
+	userSvcCli := pb.NewUserServiceClient(h.userSvcConn)
+	wallets, err := userSvcCli.GetUserWallet(h.ctx, &pb.GetUserWalletRequest{UserID: wallet.UserID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	current := Find(wallets.Wallets, wallet.ChainID, wallet.Address)
+	if current == nil {
+		_ = utils.RespondError(w, http.StatusNotFound, "wallet not found")
+		return
+	}
+	// A chain with wallets keeps its primary wallet, which is changed by setting another wallet primary
+	if current.Primary && patch["primary"] != nil && !wallet.Primary {
+		_ = utils.RespondError(w, http.StatusConflict, "primary wallet cannot be unset, set another wallet primary instead")
+		return
+	}
+	wallet.Address = current.Address
 
-	intID, _ := strconv.Atoi(id)
-	resp, err := pb.NewUserServiceClient(h.userSvcConn).GetUserWallet(h.ctx, &pb.GetUserWalletRequest{UserID: int64(intID)})
+	resp, err := userSvcCli.UpdateUserWallet(h.ctx, &pb.UpdateUserWalletRequest{
+		Wallet:     wallet,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+	})
 	if err != nil {
 		h.log.Error(err, "")
-		_ = utils.RespondError(w, http.StatusBadRequest, "response error")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	_ = utils.Respond(w, req, resp)
+}
+
+// deleteUserWalletHandler unlinks the wallet from the user. If the wallet is primary, another wallet of the user on
+// the chain becomes primary, so that the chain keeps the default address of the entries
+func (h handler) deleteUserWalletHandler(w http.ResponseWriter, req *http.Request) {
+	reqID := utils.RequestID(req)
+	log := h.log.WithValues("delete_user_wallet_request", reqID)
+
+	wallet, err := walletOf(req)
+	if err != nil {
+		_ = utils.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !auth.AuthorizeUser(w, req, wallet.UserID) {
+		return
+	}
+
+	// Serialize the changes of the primary wallets of the user
+	unlock := h.walletLocks.Lock(strconv.FormatInt(wallet.UserID, 10))
+	defer unlock()
+
+	userSvcCli := pb.NewUserServiceClient(h.userSvcConn)
+	wallets, err := userSvcCli.GetUserWallet(h.ctx, &pb.GetUserWalletRequest{UserID: wallet.UserID})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+	current := Find(wallets.Wallets, wallet.ChainID, wallet.Address)
+	if current == nil {
+		_ = utils.RespondError(w, http.StatusNotFound, "wallet not found")
+		return
+	}
+
+	log.Info("delete user wallet", "id", wallet.UserID, "chain_id", wallet.ChainID, "address", current.Address)
+
+	resp, err := userSvcCli.DeleteUserWallet(h.ctx, &pb.DeleteUserWalletRequest{
+		UserID:  current.UserID,
+		ChainID: current.ChainID,
+		Address: current.Address,
+	})
+	if err != nil {
+		h.log.Error(err, "")
+		_ = utils.RespondGRPCError(w, err)
+		return
+	}
+
+	if next := nextPrimary(wallets.Wallets, current); next != nil {
+		log.Info("promote user wallet to primary", "id", next.UserID, "chain_id", next.ChainID, "address", next.Address)
+		if _, err := userSvcCli.UpdateUserWallet(h.ctx, &pb.UpdateUserWalletRequest{
+			Wallet:     &pb.UserWallet{UserID: next.UserID, ChainID: next.ChainID, Address: next.Address, Primary: true},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"primary"}},
+		}); err != nil {
+			h.log.Error(err, "wallet is deleted, but cannot set another wallet primary")
+			_ = utils.RespondGRPCError(w, err)
+			return
+		}
+	}
+	_ = utils.Respond(w, req, resp)
+}
+
+// nextPrimary returns the wallet which becomes primary when the deleted wallet is deleted, i.e., the first other
+// wallet on the chain if the deleted one is primary. It returns nil if there is not
+func nextPrimary(wallets []*pb.UserWallet, deleted *pb.UserWallet) *pb.UserWallet {
+	if !deleted.Primary {
+		return nil
+	}
+	for _, w := range wallets {
+		if w.ChainID == deleted.ChainID && !strings.EqualFold(w.Address, deleted.Address) {
+			return w
+		}
 	}
-	_ = utils.RespondJSON(w, resp)
+	return nil
 }
@@ -0,0 +1,95 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var addressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// ValidateAddress checks if s is an EVM address (e.g., 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed).
+// Mixed-case addresses must have a valid EIP-55 checksum
+func ValidateAddress(s string) error {
+	if !addressPattern.MatchString(s) {
+		return fmt.Errorf("address must be 0x followed by 40 hexadecimal digits")
+	}
+	digits := s[2:]
+	if digits == strings.ToLower(digits) || digits == strings.ToUpper(digits) {
+		return nil
+	}
+	if ChecksumAddress(s) != s {
+		return fmt.Errorf("address has an invalid checksum")
+	}
+	return nil
+}
+
+// ChecksumAddress returns the EIP-55 mixed-case checksum encoding of a well-formed address
+func ChecksumAddress(s string) string {
+	digits := strings.ToLower(strings.TrimPrefix(s, "0x"))
+	hash := Keccak256([]byte(digits))
+	hexHash := hex.EncodeToString(hash)
+	b := []byte(digits)
+	for i, c := range b {
+		if c >= 'a' && c <= 'f' && hexHash[i] >= '8' {
+			b[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(b)
+}
+
+// Keccak256 returns the legacy Keccak-256 hash of data, as used by Ethereum
+func Keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		_, _ = h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// ChainIDs are the ids of the supported chains. Empty ChainIDs supports any chain
+type ChainIDs map[int64]bool
+
+// ParseChainIDs parses comma-separated chain ids (e.g., "1,5,137")
+func ParseChainIDs(spec string) (ChainIDs, error) {
+	ids := ChainIDs{}
+	for _, item := range SplitList(spec) {
+		id, err := strconv.ParseInt(item, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid chain id %q", item)
+		}
+		ids[id] = true
+	}
+	return ids, nil
+}
+
+// ValidateChainID checks if id is a positive id of a supported chain
+func (c ChainIDs) ValidateChainID(id int64) error {
+	if id <= 0 {
+		return fmt.Errorf("chain id must be a positive integer")
+	}
+	if len(c) > 0 && !c[id] {
+		return fmt.Errorf("chain %d is not supported", id)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ETag returns a strong entity tag of the state of msg, i.e., the hash of its deterministic wire format
+func ETag(msg proto.Message) string {
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	sum := sha256.Sum256(b)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// CheckNotModified sets the ETag header, and responds 304 Not Modified if the If-None-Match header of req matches etag.
+// It returns true if the response is done
+func CheckNotModified(w http.ResponseWriter, req *http.Request, etag string) bool {
+	w.Header().Set("ETag", etag)
+	if !etagMatches(req.Header.Get("If-None-Match"), etag, true) {
+		return false
+	}
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// CheckPrecondition checks the If-Match header of an update request against the etag of the current state.
+// It responds 428 Precondition Required if the header is missing, or 412 Precondition Failed if it does not match,
+// and returns false in the cases
+func CheckPrecondition(w http.ResponseWriter, req *http.Request, etag string) bool {
+	ifMatch := req.Header.Get("If-Match")
+	if ifMatch == "" {
+		_ = RespondError(w, http.StatusPreconditionRequired, "If-Match header is required")
+		return false
+	}
+	if !etagMatches(ifMatch, etag, false) {
+		w.Header().Set("ETag", etag)
+		_ = RespondError(w, http.StatusPreconditionFailed, "resource has been modified")
+		return false
+	}
+	return true
+}
+
+// etagMatches checks if an If-Match or If-None-Match header matches etag. Weak comparison ignores the W/ prefixes
+func etagMatches(header, etag string, weak bool) bool {
+	if header == "" {
+		return false
+	}
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if weak {
+			tag, etag = strings.TrimPrefix(tag, "W/"), strings.TrimPrefix(etag, "W/")
+		} else if strings.HasPrefix(tag, "W/") {
+			continue
+		}
+		if tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyedMutex serializes the critical sections by their keys, e.g., the read-modify-write of a resource
+type KeyedMutex struct {
+	lock  sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+// Lock locks the key, and returns the function unlocking it
+func (m *KeyedMutex) Lock(key string) func() {
+	m.lock.Lock()
+	if m.locks == nil {
+		m.locks = map[string]*keyedLock{}
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.lock.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		l.refs--
+		if l.refs == 0 {
+			delete(m.locks, key)
+		}
+	}
+}
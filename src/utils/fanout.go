@@ -0,0 +1,100 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/theraffle/frontservice/src/genproto/pb"
+	"google.golang.org/grpc/status"
+)
+
+// FanOutOptions configures the concurrent calls to the services, which compose a response
+type FanOutOptions struct {
+	// Concurrency is the maximum number of the concurrent calls of a request
+	Concurrency int
+	// Timeout is the timeout of each call
+	Timeout time.Duration
+}
+
+// DefaultFanOutOptions returns the default fan-out options
+func DefaultFanOutOptions() FanOutOptions {
+	return FanOutOptions{
+		Concurrency: 8,
+		Timeout:     3 * time.Second,
+	}
+}
+
+// FanOutOptionsFromEnv overrides the default fan-out options by FANOUT_CONCURRENCY and FANOUT_TIMEOUT
+func FanOutOptionsFromEnv() (FanOutOptions, error) {
+	opts := DefaultFanOutOptions()
+	if v := os.Getenv("FANOUT_CONCURRENCY"); v != "" {
+		concurrency, err := strconv.Atoi(v)
+		if err != nil || concurrency <= 0 {
+			return opts, fmt.Errorf("invalid FANOUT_CONCURRENCY %q", v)
+		}
+		opts.Concurrency = concurrency
+	}
+	if v := os.Getenv("FANOUT_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			return opts, fmt.Errorf("invalid FANOUT_TIMEOUT %q", v)
+		}
+		opts.Timeout = timeout
+	}
+	return opts, nil
+}
+
+// FanOut calls fn for i in [0, n) concurrently, with at most Concurrency calls at a time, and waits for all of them.
+// Each call is given a context derived from ctx, which times out after Timeout
+func (o FanOutOptions) FanOut(ctx context.Context, n int, fn func(ctx context.Context, i int)) {
+	concurrency := o.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			callCtx, cancel := ctx, context.CancelFunc(func() {})
+			if o.Timeout > 0 {
+				callCtx, cancel = context.WithTimeout(ctx, o.Timeout)
+			}
+			defer cancel()
+			fn(callCtx, i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// ErrorDetailOf converts an error of a grpc call into an ErrorDetail, which reports the failure of a part of a response
+func ErrorDetailOf(err error) *pb.ErrorDetail {
+	s := status.Convert(err)
+	return &pb.ErrorDetail{Code: strings.ToUpper(ToSnakeCase(s.Code().String())), Message: publicMessage(s)}
+}
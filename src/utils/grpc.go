@@ -22,7 +22,10 @@ import (
 	"github.com/pkg/errors"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"net/http"
 	"os"
 	"time"
 )
@@ -48,3 +51,49 @@ func MustConnGRPC(ctx context.Context, conn **grpc.ClientConn, addr string) {
 		panic(errors.Wrapf(err, "grpc: failed to connect %s", addr))
 	}
 }
+
+// HTTPStatusFromCode maps a grpc status code to the corresponding http status code
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// RespondGRPCError responds to a HTTP request with the http status corresponding to the grpc error.
+// Messages of internal errors are not exposed
+func RespondGRPCError(w http.ResponseWriter, err error) error {
+	s := status.Convert(err)
+	return RespondError(w, HTTPStatusFromCode(s.Code()), publicMessage(s))
+}
+
+// publicMessage returns the message of the status, hiding the messages of internal errors
+func publicMessage(s *status.Status) string {
+	code := HTTPStatusFromCode(s.Code())
+	if code >= http.StatusInternalServerError && code != http.StatusServiceUnavailable && code != http.StatusGatewayTimeout {
+		return "response error"
+	}
+	return s.Message()
+}
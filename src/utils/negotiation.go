@@ -0,0 +1,127 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Media types of the request and response bodies
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+	// ContentTypeMergePatch is the media type of JSON merge patches (RFC 7396)
+	ContentTypeMergePatch = "application/merge-patch+json"
+
+	contentTypeProtobufAlias = "application/protobuf"
+)
+
+// BodyContentTypes are the media types accepted for the request bodies
+var BodyContentTypes = []string{ContentTypeJSON, ContentTypeProtobuf, contentTypeProtobufAlias, ContentTypeMergePatch}
+
+// Respond responds with data, in the wire format of protobuf if data is a protobuf message
+// and req prefers application/x-protobuf by its Accept header, or in JSON otherwise
+func Respond(w http.ResponseWriter, req *http.Request, data interface{}) error {
+	w.Header().Add("Vary", "Accept")
+	msg, ok := data.(proto.Message)
+	if !ok || !AcceptsProtobuf(req) {
+		return RespondJSON(w, data)
+	}
+
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ContentTypeProtobuf)
+	_, err = w.Write(b)
+	return err
+}
+
+// AcceptsProtobuf checks if the Accept header of req prefers protobuf to JSON
+func AcceptsProtobuf(req *http.Request) bool {
+	accept := req.Header.Get("Accept")
+	if accept == "" {
+		return false
+	}
+	jsonQ, protoQ := 0.0, 0.0
+	for _, item := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		switch mediaType {
+		case ContentTypeProtobuf, contentTypeProtobufAlias:
+			protoQ = q
+		case ContentTypeJSON, "application/*", "*/*":
+			if q > jsonQ {
+				jsonQ = q
+			}
+		}
+	}
+	return protoQ > 0 && protoQ > jsonQ
+}
+
+// IsProtobufBody checks if the request body is in the wire format of protobuf by its Content-Type
+func IsProtobufBody(req *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	return err == nil && (mediaType == ContentTypeProtobuf || mediaType == contentTypeProtobufAlias)
+}
+
+// DecodeBody decodes the request body into body. JSON bodies are decoded by DecodeJSON,
+// and protobuf bodies are unmarshalled into msg, whose fields are mapped to the snake_case json fields of body
+func DecodeBody(req *http.Request, body interface{}, msg proto.Message) error {
+	if !IsProtobufBody(req) {
+		return DecodeJSON(req, body)
+	}
+
+	b, err := ReadBody(req)
+	if err != nil {
+		return err
+	}
+	if err := proto.Unmarshal(b, msg); err != nil {
+		return err
+	}
+	j, err := JSONOptions{Naming: SnakeCase}.marshal(msg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(j, body)
+}
+
+// DecodeMessage decodes the request body, in the wire format of protobuf or in JSON, directly into msg
+func DecodeMessage(req *http.Request, msg proto.Message) error {
+	b, err := ReadBody(req)
+	if err != nil {
+		return err
+	}
+	if IsProtobufBody(req) {
+		return proto.Unmarshal(b, msg)
+	}
+	return UnmarshalProtoJSON(b, msg)
+}
@@ -0,0 +1,283 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// JSON field naming styles
+const (
+	CamelCase = "camel"
+	SnakeCase = "snake"
+)
+
+// JSONOptions configures how protobuf messages are rendered in JSON
+type JSONOptions struct {
+	// Naming is the style of the field names, CamelCase (e.g., userID) or SnakeCase (e.g., user_id)
+	Naming string
+	// EnumsAsStrings renders enums by their names (e.g., "DISCORD"), instead of their numbers
+	EnumsAsStrings bool
+	// EmitDefaults renders the fields with default values (e.g., 0, "", [])
+	EmitDefaults bool
+	// Int64AsStrings renders 64-bit integers as strings, which JavaScript can handle without losing precision
+	Int64AsStrings bool
+}
+
+// DefaultJSONOptions returns the default options, following the canonical protobuf JSON mapping
+func DefaultJSONOptions() JSONOptions {
+	return JSONOptions{
+		Naming:         CamelCase,
+		EnumsAsStrings: true,
+		EmitDefaults:   true,
+		Int64AsStrings: true,
+	}
+}
+
+// JSONOptionsFromEnv overrides the default options by JSON_NAMING, JSON_ENUMS_AS_STRINGS, JSON_EMIT_DEFAULTS and JSON_INT64_AS_STRINGS
+func JSONOptionsFromEnv() (JSONOptions, error) {
+	opts := DefaultJSONOptions()
+	MapEnv(&opts.Naming, "JSON_NAMING")
+	if opts.Naming != CamelCase && opts.Naming != SnakeCase {
+		return opts, fmt.Errorf("invalid JSON_NAMING %q", opts.Naming)
+	}
+	for env, target := range map[string]*bool{
+		"JSON_ENUMS_AS_STRINGS": &opts.EnumsAsStrings,
+		"JSON_EMIT_DEFAULTS":    &opts.EmitDefaults,
+		"JSON_INT64_AS_STRINGS": &opts.Int64AsStrings,
+	} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("invalid %s %q", env, v)
+		}
+		*target = b
+	}
+	return opts, nil
+}
+
+var currentJSONOptions = DefaultJSONOptions()
+var jsonOptionsLock sync.RWMutex
+
+// SetJSONOptions sets the options used by RespondJSON
+func SetJSONOptions(opts JSONOptions) {
+	jsonOptionsLock.Lock()
+	defer jsonOptionsLock.Unlock()
+	currentJSONOptions = opts
+}
+
+func jsonOptions() JSONOptions {
+	jsonOptionsLock.RLock()
+	defer jsonOptionsLock.RUnlock()
+	return currentJSONOptions
+}
+
+func (o JSONOptions) marshal(msg proto.Message) ([]byte, error) {
+	j, err := protojson.MarshalOptions{
+		UseEnumNumbers:  !o.EnumsAsStrings,
+		EmitUnpopulated: o.EmitDefaults,
+	}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if o.Naming == CamelCase && o.Int64AsStrings {
+		return j, nil
+	}
+
+	// Rename the fields and unquote the 64-bit integers, walking along the message descriptor
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(j))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(o.transform(v, msg.ProtoReflect().Descriptor()))
+}
+
+func (o JSONOptions) transform(v interface{}, md protoreflect.MessageDescriptor) interface{} {
+	obj, ok := v.(map[string]interface{})
+	if !ok || isWellKnown(md) {
+		return v
+	}
+	fields := md.Fields()
+	out := make(map[string]interface{}, len(obj))
+	for key, val := range obj {
+		fd := fields.ByJSONName(key)
+		if fd == nil {
+			out[key] = val
+			continue
+		}
+		name := key
+		if o.Naming == SnakeCase {
+			name = ToSnakeCase(string(fd.Name()))
+		}
+		out[name] = o.transformValue(val, fd)
+	}
+	return out
+}
+
+func (o JSONOptions) transformValue(v interface{}, fd protoreflect.FieldDescriptor) interface{} {
+	if list, ok := v.([]interface{}); ok && fd.IsList() {
+		for i := range list {
+			list[i] = o.transformSingular(list[i], fd)
+		}
+		return list
+	}
+	return o.transformSingular(v, fd)
+}
+
+func (o JSONOptions) transformSingular(v interface{}, fd protoreflect.FieldDescriptor) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return o.transform(v, fd.Message())
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if s, ok := v.(string); ok && !o.Int64AsStrings {
+			return json.Number(s)
+		}
+	}
+	return v
+}
+
+func isWellKnown(md protoreflect.MessageDescriptor) bool {
+	return strings.HasPrefix(string(md.FullName()), "google.protobuf.")
+}
+
+// ToSnakeCase converts a camelCase name to snake_case (e.g., userID to user_id, raffleContract to raffle_contract)
+func ToSnakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			acronymEnd := i > 0 && unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || acronymEnd {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// UnmarshalEnum unmarshals a JSON enum value given by its name (e.g., "DISCORD") or its number
+func UnmarshalEnum(data []byte, values map[string]int32) (int32, error) {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		v, ok := values[strings.ToUpper(name)]
+		if !ok {
+			return 0, fmt.Errorf("unknown enum value %q", name)
+		}
+		return v, nil
+	}
+	var num int32
+	if err := json.Unmarshal(data, &num); err != nil {
+		return 0, fmt.Errorf("enum must be a name or a number")
+	}
+	for _, v := range values {
+		if v == num {
+			return num, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown enum value %d", num)
+}
+
+// UnmarshalProtoJSON unmarshals JSON into msg, accepting the snake_case field names (e.g., chain_id)
+// as well as the protobuf ones (e.g., chainID). Unknown fields are ignored
+func UnmarshalProtoJSON(data []byte, msg proto.Message) error {
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return fmt.Errorf("request body has data after the json value")
+	}
+	j, err := json.Marshal(normalizeNames(v, msg.ProtoReflect().Descriptor()))
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(j, msg)
+}
+
+// normalizeNames renames the fields of v to their JSON names, walking along the message descriptor
+func normalizeNames(v interface{}, md protoreflect.MessageDescriptor) interface{} {
+	obj, ok := v.(map[string]interface{})
+	if !ok || isWellKnown(md) {
+		return v
+	}
+	out := make(map[string]interface{}, len(obj))
+	for key, val := range obj {
+		fd := FieldByName(md, key)
+		if fd == nil {
+			out[key] = val
+			continue
+		}
+		switch {
+		case fd.IsMap():
+			if m, ok := val.(map[string]interface{}); ok && fd.MapValue().Kind() == protoreflect.MessageKind {
+				for k := range m {
+					m[k] = normalizeNames(m[k], fd.MapValue().Message())
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if list, ok := val.([]interface{}); ok && fd.IsList() {
+				for i := range list {
+					list[i] = normalizeNames(list[i], fd.Message())
+				}
+			} else {
+				val = normalizeNames(val, fd.Message())
+			}
+		}
+		out[fd.JSONName()] = val
+	}
+	return out
+}
+
+// FieldByName finds the field of md by its protobuf name (e.g., chainID), JSON name or snake_case name (e.g., chain_id)
+func FieldByName(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	if fd := fields.ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	if fd := fields.ByJSONName(name); fd != nil {
+		return fd
+	}
+	for i := 0; i < fields.Len(); i++ {
+		if ToSnakeCase(string(fields.Get(i).Name())) == name {
+			return fields.Get(i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// TrustedProxies is a list of networks whose forwarding headers are trusted
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses comma-separated IPs or CIDRs (e.g., "10.0.0.0/8,127.0.0.1")
+func ParseTrustedProxies(spec string) (TrustedProxies, error) {
+	var proxies TrustedProxies
+	for _, s := range strings.Split(spec, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", s)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				bits = 8 * net.IPv4len
+			}
+			s = fmt.Sprintf("%s/%d", s, bits)
+		}
+		_, network, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", s)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// Contains checks if ip is one of the trusted proxies
+func (p TrustedProxies) Contains(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range p {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTrusted checks if the request is directly sent from a trusted proxy
+func (p TrustedProxies) IsTrusted(req *http.Request) bool {
+	return p.Contains(remoteIP(req))
+}
+
+// ClientIP returns the client address of the request.
+// X-Forwarded-For is walked from the right, skipping the trusted proxies, only if the peer itself is trusted
+func (p TrustedProxies) ClientIP(req *http.Request) string {
+	ip := remoteIP(req)
+	if !p.Contains(ip) {
+		return ip
+	}
+
+	var hops []string
+	for _, h := range req.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(h, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !p.Contains(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+func remoteIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
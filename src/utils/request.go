@@ -0,0 +1,87 @@
+/*
+ Copyright 2022 The Raffle Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// MaxRequestIDLength is the maximum length of the request ids accepted from the clients
+const MaxRequestIDLength = 64
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the id of the request, generating a new one if the request has none
+func RequestID(req *http.Request) string {
+	if id, ok := req.Context().Value(requestIDKey{}).(string); ok && id != "" {
+		return id
+	}
+	return RandomString(10)
+}
+
+// ErrBodyTooLarge is returned by the decoders if the request body exceeds the limit of http.MaxBytesReader
+var ErrBodyTooLarge = errors.New("request body is too large")
+
+// bodyError maps the error of http.MaxBytesReader to ErrBodyTooLarge. The error is matched by its message,
+// as http.MaxBytesError is not available in go 1.17
+func bodyError(err error) error {
+	if err != nil && strings.HasSuffix(err.Error(), "request body too large") {
+		return ErrBodyTooLarge
+	}
+	return err
+}
+
+// ReadBody reads the whole request body, returning ErrBodyTooLarge if it exceeds the limit
+func ReadBody(req *http.Request) ([]byte, error) {
+	b, err := ioutil.ReadAll(req.Body)
+	return b, bodyError(err)
+}
+
+// DecodeJSON decodes the json body of the request into v, rejecting any data after the json value
+func DecodeJSON(req *http.Request, v interface{}) error {
+	decoder := json.NewDecoder(req.Body)
+	if err := decoder.Decode(v); err != nil {
+		return bodyError(err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		if err := bodyError(err); err == ErrBodyTooLarge {
+			return err
+		}
+		return fmt.Errorf("request body has data after the json value")
+	}
+	return nil
+}
+
+// RespondDecodeError responds 413 Request Entity Too Large if err is ErrBodyTooLarge, or 400 Bad Request with msg
+func RespondDecodeError(w http.ResponseWriter, err error, msg string) error {
+	if errors.Is(err, ErrBodyTooLarge) {
+		return RespondError(w, http.StatusRequestEntityTooLarge, ErrBodyTooLarge.Error())
+	}
+	return RespondError(w, http.StatusBadRequest, msg)
+}
@@ -19,17 +19,42 @@ package utils
 import (
 	"encoding/json"
 	"net/http"
+
+	"google.golang.org/protobuf/proto"
 )
 
-// RespondJSON responds with arbitrary data objects
+// RespondJSON responds with arbitrary data objects.
+// Protobuf messages are rendered by protojson, with the JSONOptions set by SetJSONOptions
 func RespondJSON(w http.ResponseWriter, data interface{}) error {
+	return respondJSON(w, http.StatusOK, data)
+}
+
+// ErrorResponse is a common struct for responding error for HTTP requests
+type ErrorResponse struct {
+	Message string `json:"message"`
+	// Reason is the machine-readable cause of the error, if any
+	Reason string `json:"reason,omitempty"`
+}
+
+// RespondError responds to a HTTP request with body of ErrorResponse
+func RespondError(w http.ResponseWriter, code int, msg string) error {
+	return respondJSON(w, code, ErrorResponse{Message: msg})
+}
+
+// RespondErrorReason responds to a HTTP request with body of ErrorResponse, with its reason
+func RespondErrorReason(w http.ResponseWriter, code int, reason, msg string) error {
+	return respondJSON(w, code, ErrorResponse{Message: msg, Reason: reason})
+}
+
+func respondJSON(w http.ResponseWriter, code int, data interface{}) error {
 	w.Header().Set("Content-Type", "application/json")
 
-	j, err := json.Marshal(data)
+	j, err := MarshalJSON(data)
 	if err != nil {
 		return err
 	}
 
+	w.WriteHeader(code)
 	_, err = w.Write(j)
 	if err != nil {
 		return err
@@ -38,13 +63,10 @@ func RespondJSON(w http.ResponseWriter, data interface{}) error {
 	return nil
 }
 
-// ErrorResponse is a common struct for responding error for HTTP requests
-type ErrorResponse struct {
-	Message string `json:"message"`
-}
-
-// RespondError responds to a HTTP request with body of ErrorResponse
-func RespondError(w http.ResponseWriter, code int, msg string) error {
-	w.WriteHeader(code)
-	return RespondJSON(w, ErrorResponse{Message: msg})
+// MarshalJSON marshals protobuf messages by protojson and other objects by encoding/json
+func MarshalJSON(data interface{}) ([]byte, error) {
+	if msg, ok := data.(proto.Message); ok {
+		return jsonOptions().marshal(msg)
+	}
+	return json.Marshal(data)
 }